@@ -9,7 +9,9 @@ import (
 )
 
 var cmds = map[string]framework.Command{
-	"app:serve": NewServeCommand(),
+	"app:serve":        NewServeCommand(),
+	"migrate:rollback": NewMigrateRollbackCommand(),
+	"seed:run":         NewSeedCommand(),
 }
 
 // GetSubCommands gives a list of sub commands
@@ -0,0 +1,37 @@
+package console
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+
+	"github.com/spf13/cobra"
+)
+
+// MigrateRollbackCommand reverts applied migrations
+type MigrateRollbackCommand struct {
+	steps int
+}
+
+func (m *MigrateRollbackCommand) Short() string {
+	return "rollback applied migrations"
+}
+
+func (m *MigrateRollbackCommand) Setup(cmd *cobra.Command) {
+	cmd.Flags().IntVarP(&m.steps, "steps", "s", 1, "number of migrations to rollback")
+}
+
+func (m *MigrateRollbackCommand) Run() framework.CommandRunner {
+	return func(
+		logger framework.Logger,
+		database infrastructure.Database,
+	) {
+		logger.Infof("Rolling back %d migrations", m.steps)
+		if err := database.RollbackMigration(m.steps); err != nil {
+			logger.Fatal(err)
+		}
+	}
+}
+
+func NewMigrateRollbackCommand() *MigrateRollbackCommand {
+	return &MigrateRollbackCommand{}
+}
@@ -0,0 +1,38 @@
+package console
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/seeds"
+
+	"github.com/spf13/cobra"
+)
+
+// SeedCommand seeds sample data for local development
+type SeedCommand struct{}
+
+func (s *SeedCommand) Short() string {
+	return "seed sample data for local development"
+}
+
+func (s *SeedCommand) Setup(cmd *cobra.Command) {}
+
+func (s *SeedCommand) Run() framework.CommandRunner {
+	return func(
+		logger framework.Logger,
+		database infrastructure.Database,
+		devSeed seeds.DevSeed,
+	) {
+		if !devSeed.Enabled() {
+			logger.Fatal("seeding is disabled, set SEED_ENABLED=true outside production to enable it")
+			return
+		}
+
+		database.RunMigration()
+		devSeed.Setup()
+	}
+}
+
+func NewSeedCommand() *SeedCommand {
+	return &SeedCommand{}
+}
@@ -3,7 +3,6 @@ package console
 import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
-	"clean-architecture/pkg/middlewares"
 	"time"
 
 	"github.com/getsentry/sentry-go"
@@ -21,7 +20,6 @@ func (s *ServeCommand) Setup(cmd *cobra.Command) {}
 
 func (s *ServeCommand) Run() framework.CommandRunner {
 	return func(
-		middleware middlewares.Middlewares,
 		env *framework.Env,
 		router infrastructure.Router,
 		logger framework.Logger,
@@ -37,7 +35,6 @@ func (s *ServeCommand) Run() framework.CommandRunner {
 		loc, _ := time.LoadLocation(env.TimeZone)
 		time.Local = loc
 
-		middleware.Setup()
 		//seeds.Setup()
 		database.RunMigration()
 
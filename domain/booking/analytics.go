@@ -0,0 +1,29 @@
+package booking
+
+import (
+	"time"
+
+	"clean-architecture/domain/models"
+)
+
+// summarizeBookings sums the bookings starting in [from, to) and counts them per UTC day,
+// listing every day of the range including the ones without bookings
+func summarizeBookings(bookings []models.Booking, from, to time.Time) BookingAnalytics {
+	var booked time.Duration
+	countByDay := make(map[string]int64)
+	for _, booking := range bookings {
+		booked += booking.EndTime.Sub(booking.StartTime)
+		countByDay[booking.StartTime.UTC().Format(time.DateOnly)]++
+	}
+
+	analytics := BookingAnalytics{
+		TotalBookings:    int64(len(bookings)),
+		TotalBookedHours: booked.Hours(),
+		ByDay:            []BookingDayCount{},
+	}
+	for day := from.UTC().Truncate(24 * time.Hour); day.Before(to); day = day.AddDate(0, 0, 1) {
+		key := day.Format(time.DateOnly)
+		analytics.ByDay = append(analytics.ByDay, BookingDayCount{Day: key, Count: countByDay[key]})
+	}
+	return analytics
+}
@@ -0,0 +1,55 @@
+package booking
+
+import (
+	"clean-architecture/domain/models"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Domain/Booking/summarizeBookings", func() {
+	day := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time {
+		return day.Add(time.Duration(hour) * time.Hour)
+	}
+
+	It("should sum the booked hours and count the bookings per day", func() {
+		bookings := []models.Booking{
+			{StartTime: at(9), EndTime: at(11)},
+			{StartTime: at(14), EndTime: at(14).Add(30 * time.Minute)},
+			{StartTime: at(24 + 10), EndTime: at(24 + 11)},
+		}
+
+		analytics := summarizeBookings(bookings, day, day.AddDate(0, 0, 3))
+
+		Expect(analytics.TotalBookings).To(Equal(int64(3)))
+		Expect(analytics.TotalBookedHours).To(Equal(3.5))
+		Expect(analytics.ByDay).To(Equal([]BookingDayCount{
+			{Day: "2030-01-07", Count: 2},
+			{Day: "2030-01-08", Count: 1},
+			{Day: "2030-01-09", Count: 0},
+		}))
+	})
+
+	It("should bucket bookings by their UTC day whatever their time zone", func() {
+		kathmandu := time.FixedZone("NPT", 5*60*60+45*60)
+		// 2030-01-08 02:00 in Kathmandu is still 2030-01-07 in UTC
+		late := at(20).Add(15 * time.Minute).In(kathmandu)
+		bookings := []models.Booking{{StartTime: late, EndTime: late.Add(time.Hour)}}
+
+		analytics := summarizeBookings(bookings, day, day.AddDate(0, 0, 2))
+
+		Expect(analytics.ByDay).To(Equal([]BookingDayCount{
+			{Day: "2030-01-07", Count: 1},
+			{Day: "2030-01-08", Count: 0},
+		}))
+	})
+
+	It("should list every day of an empty range", func() {
+		analytics := summarizeBookings(nil, day, day.AddDate(0, 0, 1))
+
+		Expect(analytics.TotalBookings).To(BeZero())
+		Expect(analytics.ByDay).To(Equal([]BookingDayCount{{Day: "2030-01-07", Count: 0}}))
+	})
+})
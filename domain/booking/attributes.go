@@ -0,0 +1,85 @@
+package booking
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"gorm.io/datatypes"
+)
+
+// attributeType is the JSON type an attribute value must have
+type attributeType string
+
+const (
+	attributeString  attributeType = "string"
+	attributeNumber  attributeType = "number"
+	attributeBoolean attributeType = "boolean"
+)
+
+// resourceAttributeSchemas are the allowed attributes per resource type
+// Resource types without a schema keep free-form attributes
+var resourceAttributeSchemas = map[string]map[string]attributeType{
+	"room": {
+		"has_projector":          attributeBoolean,
+		"has_video_conferencing": attributeBoolean,
+		"has_whiteboard":         attributeBoolean,
+		"size_sqft":              attributeNumber,
+		"floor":                  attributeNumber,
+	},
+	"equipment": {
+		"brand":         attributeString,
+		"model":         attributeString,
+		"serial_number": attributeString,
+		"portable":      attributeBoolean,
+	},
+}
+
+// validateAttributes validates resource attributes against the schema of the resource type
+func validateAttributes(resourceType string, attributes datatypes.JSON) error {
+	schema, ok := resourceAttributeSchemas[resourceType]
+	if !ok || len(attributes) == 0 || string(attributes) == "null" {
+		return nil
+	}
+
+	var values map[string]any
+	if err := json.Unmarshal(attributes, &values); err != nil {
+		return fmt.Errorf("%w: attributes must be an object", ErrInvalidResourceAttributes)
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		expected, ok := schema[key]
+		if !ok {
+			return fmt.Errorf("%w: unknown attribute %q for %s", ErrInvalidResourceAttributes, key, resourceType)
+		}
+		if actual := jsonType(values[key]); actual != expected {
+			return fmt.Errorf("%w: attribute %q must be a %s, got %s", ErrInvalidResourceAttributes, key, expected, actual)
+		}
+	}
+
+	return nil
+}
+
+// jsonType returns the attribute type of a decoded JSON value
+func jsonType(value any) attributeType {
+	switch value.(type) {
+	case string:
+		return attributeString
+	case float64:
+		return attributeNumber
+	case bool:
+		return attributeBoolean
+	case nil:
+		return "null"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
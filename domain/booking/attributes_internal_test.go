@@ -0,0 +1,31 @@
+package booking
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"gorm.io/datatypes"
+)
+
+var _ = Describe("Domain/Booking/validateAttributes", func() {
+	DescribeTable("should accept conforming attributes",
+		func(resourceType, attributes string) {
+			Expect(validateAttributes(resourceType, datatypes.JSON(attributes))).To(Succeed())
+		},
+		Entry("room attributes", "room", `{"has_projector": true, "size_sqft": 400}`),
+		Entry("equipment attributes", "equipment", `{"brand": "Epson", "portable": true}`),
+		Entry("empty attributes", "room", ``),
+		Entry("null attributes", "room", `null`),
+		Entry("type without schema", "service", `{"anything": ["goes"]}`),
+	)
+
+	DescribeTable("should reject schema violations",
+		func(resourceType, attributes string) {
+			err := validateAttributes(resourceType, datatypes.JSON(attributes))
+			Expect(err).To(MatchError(ErrInvalidResourceAttributes))
+		},
+		Entry("unknown key", "room", `{"has_sauna": true}`),
+		Entry("wrong typed key", "room", `{"size_sqft": "400"}`),
+		Entry("null value", "equipment", `{"brand": null}`),
+		Entry("not an object", "room", `["has_projector"]`),
+	)
+})
@@ -0,0 +1,20 @@
+package booking_test
+
+import (
+	"clean-architecture/pkg/utils"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestBooking(t *testing.T) {
+	utils.ChDir()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Booking Suite")
+}
+
+var t GinkgoTInterface
+var _ = BeforeSuite(func() {
+	t = GinkgoT()
+})
@@ -0,0 +1,51 @@
+package booking
+
+import (
+	"slices"
+	"time"
+
+	"clean-architecture/domain/models"
+)
+
+// conflictGroup is a set of bookings whose time ranges overlap each other, directly or through other bookings of the group
+type conflictGroup struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Bookings  []models.Booking
+}
+
+// groupConflicts groups bookings overlapping each other, leaving out the bookings overlapping no other one
+// bookings ending exactly when the next one starts don't overlap
+func groupConflicts(bookings []models.Booking) []conflictGroup {
+	sorted := slices.Clone(bookings)
+	slices.SortFunc(sorted, func(a, b models.Booking) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	var groups []conflictGroup
+	var current conflictGroup
+	flush := func() {
+		if len(current.Bookings) > 1 {
+			groups = append(groups, current)
+		}
+	}
+
+	for _, booking := range sorted {
+		if len(current.Bookings) > 0 && booking.StartTime.Before(current.EndTime) {
+			current.Bookings = append(current.Bookings, booking)
+			if booking.EndTime.After(current.EndTime) {
+				current.EndTime = booking.EndTime
+			}
+			continue
+		}
+		flush()
+		current = conflictGroup{
+			StartTime: booking.StartTime,
+			EndTime:   booking.EndTime,
+			Bookings:  []models.Booking{booking},
+		}
+	}
+	flush()
+
+	return groups
+}
@@ -0,0 +1,59 @@
+package booking
+
+import (
+	"clean-architecture/domain/models"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Domain/Booking/groupConflicts", func() {
+	day := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time {
+		return day.Add(time.Duration(hour) * time.Hour)
+	}
+	booking := func(reference string, from, to int) models.Booking {
+		return models.Booking{Reference: reference, StartTime: at(from), EndTime: at(to)}
+	}
+	references := func(group conflictGroup) []string {
+		var refs []string
+		for _, b := range group.Bookings {
+			refs = append(refs, b.Reference)
+		}
+		return refs
+	}
+
+	It("should group bookings overlapping directly or through another booking", func() {
+		groups := groupConflicts([]models.Booking{
+			booking("late", 15, 17),
+			booking("chained", 11, 13),
+			booking("first", 9, 12),
+			booking("alone", 13, 14),
+			booking("overlapping late", 16, 18),
+		})
+
+		Expect(groups).To(HaveLen(2))
+		Expect(references(groups[0])).To(Equal([]string{"first", "chained"}))
+		Expect(groups[0].StartTime).To(Equal(at(9)))
+		Expect(groups[0].EndTime).To(Equal(at(13)))
+		Expect(references(groups[1])).To(Equal([]string{"late", "overlapping late"}))
+		Expect(groups[1].EndTime).To(Equal(at(18)))
+	})
+
+	It("should keep a long booking spanning several others in one group", func() {
+		groups := groupConflicts([]models.Booking{
+			booking("all day", 9, 17),
+			booking("morning", 10, 11),
+			booking("afternoon", 14, 15),
+		})
+
+		Expect(groups).To(HaveLen(1))
+		Expect(groups[0].Bookings).To(HaveLen(3))
+	})
+
+	It("should not group adjacent or separate bookings", func() {
+		Expect(groupConflicts([]models.Booking{booking("a", 9, 10), booking("b", 10, 11)})).To(BeEmpty())
+		Expect(groupConflicts(nil)).To(BeEmpty())
+	})
+})
@@ -4,36 +4,40 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
-	"time"
+	"strings"
 
 	"clean-architecture/domain/models"
+	"clean-architecture/domain/user"
 	"clean-architecture/pkg/errorz"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/responses"
 	"clean-architecture/pkg/types"
+	"clean-architecture/pkg/utils"
 
 	"github.com/gin-gonic/gin"
-	"github.com/google/uuid"
 	"gorm.io/datatypes"
 )
 
 // Controller handles HTTP requests for the booking system
 type Controller struct {
-	service *Service
-	logger  framework.Logger
-	env     *framework.Env
+	service     *Service
+	userService *user.Service
+	logger      framework.Logger
+	env         *framework.Env
 }
 
 // NewController creates a new booking controller
 func NewController(
 	service *Service,
+	userService *user.Service,
 	logger framework.Logger,
 	env *framework.Env,
 ) *Controller {
 	return &Controller{
-		service: service,
-		logger:  logger,
-		env:     env,
+		service:     service,
+		userService: userService,
+		logger:      logger,
+		env:         env,
 	}
 }
 
@@ -67,16 +71,18 @@ func (c *Controller) CreateResource(ctx *gin.Context) {
 
 	// Convert request to model
 	resource := models.Resource{
-		Name:        req.Name,
-		Description: req.Description,
-		Type:        req.Type,
-		Capacity:    req.Capacity,
-		Location:    req.Location,
-		Attributes:  attributes,
+		Name:          req.Name,
+		Description:   req.Description,
+		Type:          req.Type,
+		Capacity:      req.Capacity,
+		Location:      req.Location,
+		Attributes:    attributes,
+		BufferMinutes: req.BufferMinutes,
+		SeatBased:     req.SeatBased,
 	}
 
 	// Create resource
-	if err := c.service.CreateResource(&resource); err != nil {
+	if err := c.serviceFor(ctx).CreateResource(&resource); err != nil {
 		c.logger.Errorf("[BookingController...CreateResource] Error: %v", err)
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -103,12 +109,12 @@ func (c *Controller) GetResourceByID(ctx *gin.Context) {
 	idParam := ctx.Param("id")
 	parsedID, err := types.ShouldParseUUID(idParam)
 	if err != nil {
-		responses.HandleValidationError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
 	// Get resource
-	resource, err := c.service.GetResourceByID(parsedID)
+	resource, err := c.serviceFor(ctx).GetResourceByID(parsedID)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -135,7 +141,7 @@ func (c *Controller) UpdateResource(ctx *gin.Context) {
 	idParam := ctx.Param("id")
 	parsedID, err := types.ShouldParseUUID(idParam)
 	if err != nil {
-		responses.HandleValidationError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
@@ -147,7 +153,7 @@ func (c *Controller) UpdateResource(ctx *gin.Context) {
 	}
 
 	// Update resource
-	err = c.service.UpdateResource(parsedID, func(resource *models.Resource) error {
+	err = c.serviceFor(ctx).UpdateResource(parsedID, func(resource *models.Resource) error {
 		if req.Name != "" {
 			resource.Name = req.Name
 		}
@@ -163,6 +169,12 @@ func (c *Controller) UpdateResource(ctx *gin.Context) {
 		if req.Location != "" {
 			resource.Location = req.Location
 		}
+		if req.BufferMinutes != nil {
+			resource.BufferMinutes = *req.BufferMinutes
+		}
+		if req.SeatBased != nil {
+			resource.SeatBased = *req.SeatBased
+		}
 		if req.Attributes != nil {
 			attributesBytes, err := json.Marshal(req.Attributes)
 			if err != nil {
@@ -184,7 +196,7 @@ func (c *Controller) UpdateResource(ctx *gin.Context) {
 	}
 
 	// Get updated resource
-	resource, err := c.service.GetResourceByID(parsedID)
+	resource, err := c.serviceFor(ctx).GetResourceByID(parsedID)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -211,12 +223,12 @@ func (c *Controller) DeleteResource(ctx *gin.Context) {
 	idParam := ctx.Param("id")
 	parsedID, err := types.ShouldParseUUID(idParam)
 	if err != nil {
-		responses.HandleValidationError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
 	// Delete resource
-	if err := c.service.DeleteResource(parsedID); err != nil {
+	if err := c.serviceFor(ctx).DeleteResource(parsedID); err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
@@ -225,21 +237,60 @@ func (c *Controller) DeleteResource(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+// StartResourceMaintenance handles putting a resource under maintenance
+func (c *Controller) StartResourceMaintenance(ctx *gin.Context) {
+	c.logger.Info("[BookingController...StartResourceMaintenance]")
+	c.setResourceMaintenance(ctx, true, "Resource is now under maintenance")
+}
+
+// EndResourceMaintenance handles clearing maintenance of a resource
+func (c *Controller) EndResourceMaintenance(ctx *gin.Context) {
+	c.logger.Info("[BookingController...EndResourceMaintenance]")
+	c.setResourceMaintenance(ctx, false, "Resource maintenance cleared")
+}
+
+func (c *Controller) setResourceMaintenance(ctx *gin.Context, underMaintenance bool, message string) {
+	// Parse ID parameter
+	idParam := ctx.Param("id")
+	parsedID, err := types.ShouldParseUUID(idParam)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	if err := c.serviceFor(ctx).SetResourceMaintenance(parsedID, underMaintenance); err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Get updated resource
+	resource, err := c.serviceFor(ctx).GetResourceByID(parsedID)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[ResourceResponseDTO]{
+			Item:    ResourceToDTO(&resource),
+			Message: message,
+		},
+	)
+}
+
 // ListResources handles the list resources request with pagination
 func (c *Controller) ListResources(ctx *gin.Context) {
 	c.logger.Info("[BookingController...ListResources]")
 
 	// Parse pagination parameters
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
-
-	// Validate pagination parameters
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
+	pagination, err := utils.ParsePagination(ctx)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
 	}
+	page, limit := pagination.Page, pagination.Limit
 
 	// Parse filters
 	filters := make(map[string]interface{})
@@ -256,8 +307,11 @@ func (c *Controller) ListResources(ctx *gin.Context) {
 		}
 	}
 
+	// Parse search on name and description
+	search := strings.TrimSpace(ctx.Query("search"))
+
 	// Get resources
-	resources, total, err := c.service.ListResources(page, limit, filters)
+	resources, total, err := c.serviceFor(ctx).ListResources(page, limit, search, filters)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -274,12 +328,9 @@ func (c *Controller) ListResources(ctx *gin.Context) {
 		ctx,
 		http.StatusOK,
 		responses.ListResponseType[ResourceResponseDTO]{
-			Items: items,
-			Pagination: responses.PaginationResponseType{
-				Total:   total,
-				HasNext: int64(page*limit) < total,
-			},
-			Message: "Resources retrieved successfully",
+			Items:      items,
+			Pagination: responses.NewPagination(page, limit, total),
+			Message:    "Resources retrieved successfully",
 		},
 	)
 }
@@ -292,9 +343,9 @@ func (c *Controller) CreateAvailability(ctx *gin.Context) {
 
 	// Parse resource ID parameter
 	resourceIDParam := ctx.Param("id")
-	resourceID, err := uuid.Parse(resourceIDParam)
+	resourceID, err := types.ShouldParseUUID(resourceIDParam)
 	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
@@ -313,7 +364,7 @@ func (c *Controller) CreateAvailability(ctx *gin.Context) {
 	}
 
 	// Create availability
-	if err := c.service.CreateAvailability(types.BinaryUUID(resourceID), &availability); err != nil {
+	if err := c.serviceFor(ctx).CreateAvailability(resourceID, &availability); err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
@@ -331,15 +382,62 @@ func (c *Controller) CreateAvailability(ctx *gin.Context) {
 	)
 }
 
+// GenerateAvailabilities handles generating availabilities from a weekly schedule
+func (c *Controller) GenerateAvailabilities(ctx *gin.Context) {
+	c.logger.Info("[BookingController...GenerateAvailabilities]")
+
+	// Parse resource ID parameter
+	resourceIDParam := ctx.Param("id")
+	resourceID, err := types.ShouldParseUUID(resourceIDParam)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	var req AvailabilityGenerateDTO
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	availabilities, err := c.serviceFor(ctx).GenerateAvailabilities(
+		resourceID,
+		req.DaysOfWeek,
+		req.StartTime,
+		req.EndTime,
+		req.From,
+		req.Until,
+	)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Convert to response DTOs
+	response := make([]AvailabilityResponseDTO, len(availabilities))
+	for i := range availabilities {
+		response[i] = AvailabilityToDTO(&availabilities[i])
+	}
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusCreated,
+		responses.DetailResponseType[[]AvailabilityResponseDTO]{
+			Item:    response,
+			Message: "Availabilities generated successfully",
+		},
+	)
+}
+
 // CheckResourceAvailability handles the check resource availability request
 func (c *Controller) CheckResourceAvailability(ctx *gin.Context) {
 	c.logger.Info("[BookingController...CheckResourceAvailability]")
 
 	// Parse resource ID parameter
 	resourceIDParam := ctx.Param("id")
-	resourceID, err := uuid.Parse(resourceIDParam)
+	resourceID, err := types.ShouldParseUUID(resourceIDParam)
 	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
@@ -351,8 +449,8 @@ func (c *Controller) CheckResourceAvailability(ctx *gin.Context) {
 	}
 
 	// Check resource availability
-	available, err := c.service.CheckResourceAvailability(
-		types.BinaryUUID(resourceID),
+	available, err := c.serviceFor(ctx).CheckResourceAvailability(
+		resourceID,
 		query.StartTime,
 		query.EndTime,
 	)
@@ -382,14 +480,22 @@ func (c *Controller) ListResourceAvailabilities(ctx *gin.Context) {
 
 	// Parse resource ID parameter
 	resourceIDParam := ctx.Param("id")
-	resourceID, err := uuid.Parse(resourceIDParam)
+	resourceID, err := types.ShouldParseUUID(resourceIDParam)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	// Parse pagination parameters
+	pagination, err := utils.ParsePagination(ctx)
 	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
+	page, limit := pagination.Page, pagination.Limit
 
 	// Get availabilities
-	availabilities, err := c.service.ListAvailabilitiesByResourceID(types.BinaryUUID(resourceID))
+	availabilities, total, err := c.serviceFor(ctx).ListAvailabilitiesByResourceID(resourceID, page, limit)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -405,12 +511,9 @@ func (c *Controller) ListResourceAvailabilities(ctx *gin.Context) {
 		ctx,
 		http.StatusOK,
 		responses.ListResponseType[AvailabilityResponseDTO]{
-			Items:   items,
-			Message: "Availabilities retrieved successfully",
-			Pagination: responses.PaginationResponseType{
-				Total:   int64(len(items)),
-				HasNext: false,
-			},
+			Items:      items,
+			Message:    "Availabilities retrieved successfully",
+			Pagination: responses.NewPagination(page, limit, total),
 		},
 	)
 }
@@ -419,64 +522,45 @@ func (c *Controller) ListResourceAvailabilities(ctx *gin.Context) {
 func (c *Controller) CheckMultipleResourcesAvailability(ctx *gin.Context) {
 	c.logger.Info("[BookingController...CheckMultipleResourcesAvailability]")
 
-	// Parse resource IDs
-	resourceIDsParam := ctx.QueryArray("resource_ids")
-	if len(resourceIDsParam) == 0 {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
-		return
-	}
-
-	// Parse query parameters
-	startStr := ctx.Query("start")
-	endStr := ctx.Query("end")
-
-	if startStr == "" || endStr == "" {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
-		return
-	}
-
-	start, err := time.Parse(time.RFC3339, startStr)
-	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
+	var query MultipleAvailabilityCheckDTO
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
-	end, err := time.Parse(time.RFC3339, endStr)
-	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
-		return
+	// Parse resource IDs, rejecting the request on the first malformed one
+	resourceIDs := make([]types.BinaryUUID, len(query.ResourceIDs))
+	for i, idStr := range query.ResourceIDs {
+		id, err := types.ShouldParseUUID(idStr)
+		if err != nil {
+			responses.HandleValidationError(ctx, c.logger, err)
+			return
+		}
+		resourceIDs[i] = id
 	}
 
 	// Check availability for each resource
 	results := make(map[string]bool)
 
-	for _, idStr := range resourceIDsParam {
-		id, err := uuid.Parse(idStr)
-		if err != nil {
-			// Skip invalid IDs
-			continue
-		}
-
-		available, err := c.service.CheckResourceAvailability(types.BinaryUUID(id), start, end)
+	for i, id := range resourceIDs {
+		available, err := c.serviceFor(ctx).CheckResourceAvailability(id, query.StartTime, query.EndTime)
 		if err != nil {
 			// Skip resources with errors
 			continue
 		}
 
-		results[idStr] = available
+		results[query.ResourceIDs[i]] = available
 	}
 
 	// Return results
-	response := struct {
-		Results map[string]bool `json:"results"`
-	}{
+	response := MultipleAvailabilityCheckResponseDTO{
 		Results: results,
 	}
 
 	responses.DetailResponse(
 		ctx,
 		http.StatusOK,
-		responses.DetailResponseType[interface{}]{
+		responses.DetailResponseType[MultipleAvailabilityCheckResponseDTO]{
 			Item:    response,
 			Message: "Availability check completed",
 		},
@@ -495,37 +579,42 @@ func (c *Controller) CreateBooking(ctx *gin.Context) {
 		return
 	}
 
-	// Get user ID from context
-	userIDStr := ctx.GetString("user_id")
-	if userIDStr == "" {
-		responses.HandleError(ctx, c.logger, errorz.ErrUnauthorized)
+	// Get the signed in user
+	identity, err := c.identity(ctx)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
 		return
 	}
-
-	userID, err := uuid.Parse(userIDStr)
-	if err != nil {
+	if !identity.IsAuthenticated() {
 		responses.HandleError(ctx, c.logger, errorz.ErrUnauthorized)
 		return
 	}
+	userID := identity.UserID
 
 	// Convert request to model
 	booking := models.Booking{
 		ResourceID: req.ResourceID,
-		UserID:     types.BinaryUUID(userID),
+		UserID:     userID,
 		StartTime:  req.StartTime,
 		EndTime:    req.EndTime,
 		Notes:      req.Notes,
 		Reference:  req.Reference,
+		Seats:      req.Seats,
 	}
 
 	// Create booking
-	if err := c.service.CreateBooking(&booking); err != nil {
+	if err := c.serviceFor(ctx).CreateBooking(&booking); err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
 	// Convert to response DTO
-	response := BookingToDTO(&booking)
+	dtos, err := c.bookingDTOs(ctx, booking)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+	response := dtos[0]
 
 	responses.DetailResponse(
 		ctx,
@@ -543,36 +632,31 @@ func (c *Controller) GetBookingByID(ctx *gin.Context) {
 
 	// Parse ID parameter
 	idParam := ctx.Param("id")
-	id, err := uuid.Parse(idParam)
+	id, err := types.ShouldParseUUID(idParam)
 	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
 	// Get booking
-	booking, err := c.service.GetBookingByID(types.BinaryUUID(id))
+	booking, err := c.serviceFor(ctx).GetBookingByID(id)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
 	// Authorization check: user can only see their own bookings unless they're an admin
-	// TODO: Implement proper auth check with roles
-	userIDStr := ctx.GetString("user_id")
-	if userIDStr != "" {
-		userID, err := uuid.Parse(userIDStr)
-		if err == nil && booking.UserID != types.BinaryUUID(userID) {
-			// Check if user has admin role
-			isAdmin := ctx.GetBool("is_admin") // Assuming this is set by auth middleware
-			if !isAdmin {
-				responses.HandleError(ctx, c.logger, errorz.ErrForbidden)
-				return
-			}
-		}
+	if !c.authorizeOwner(ctx, booking.UserID) {
+		return
 	}
 
 	// Convert to response DTO
-	response := BookingToDTO(&booking)
+	dtos, err := c.bookingDTOs(ctx, booking)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+	response := dtos[0]
 
 	responses.DetailResponse(
 		ctx,
@@ -590,31 +674,22 @@ func (c *Controller) UpdateBooking(ctx *gin.Context) {
 
 	// Parse ID parameter
 	idParam := ctx.Param("id")
-	id, err := uuid.Parse(idParam)
+	id, err := types.ShouldParseUUID(idParam)
 	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
 	// Get booking to check authorization
-	booking, err := c.service.GetBookingByID(types.BinaryUUID(id))
+	booking, err := c.serviceFor(ctx).GetBookingByID(id)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
 	// Authorization check: user can only update their own bookings unless they're an admin
-	userIDStr := ctx.GetString("user_id")
-	if userIDStr != "" {
-		userID, err := uuid.Parse(userIDStr)
-		if err == nil && booking.UserID != types.BinaryUUID(userID) {
-			// Check if user has admin role
-			isAdmin := ctx.GetBool("is_admin") // Assuming this is set by auth middleware
-			if !isAdmin {
-				responses.HandleError(ctx, c.logger, errorz.ErrForbidden)
-				return
-			}
-		}
+	if !c.authorizeOwner(ctx, booking.UserID) {
+		return
 	}
 
 	// Parse request body
@@ -625,7 +700,7 @@ func (c *Controller) UpdateBooking(ctx *gin.Context) {
 	}
 
 	// Update booking
-	err = c.service.UpdateBooking(types.BinaryUUID(id), func(booking *models.Booking) error {
+	err = c.serviceFor(ctx).UpdateBooking(id, func(booking *models.Booking) error {
 		// Only update fields that were provided
 		timeChanged := false
 
@@ -661,14 +736,19 @@ func (c *Controller) UpdateBooking(ctx *gin.Context) {
 	}
 
 	// Get updated booking
-	updatedBooking, err := c.service.GetBookingByID(types.BinaryUUID(id))
+	updatedBooking, err := c.serviceFor(ctx).GetBookingByID(id)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
 	// Convert to response DTO
-	response := BookingToDTO(&updatedBooking)
+	dtos, err := c.bookingDTOs(ctx, updatedBooking)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+	response := dtos[0]
 
 	responses.DetailResponse(
 		ctx,
@@ -686,35 +766,26 @@ func (c *Controller) CancelBooking(ctx *gin.Context) {
 
 	// Parse ID parameter
 	idParam := ctx.Param("id")
-	id, err := uuid.Parse(idParam)
+	id, err := types.ShouldParseUUID(idParam)
 	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
 	// Get booking to check authorization
-	booking, err := c.service.GetBookingByID(types.BinaryUUID(id))
+	booking, err := c.serviceFor(ctx).GetBookingByID(id)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
 	// Authorization check: user can only cancel their own bookings unless they're an admin
-	userIDStr := ctx.GetString("user_id")
-	if userIDStr != "" {
-		userID, err := uuid.Parse(userIDStr)
-		if err == nil && booking.UserID != types.BinaryUUID(userID) {
-			// Check if user has admin role
-			isAdmin := ctx.GetBool("is_admin") // Assuming this is set by auth middleware
-			if !isAdmin {
-				responses.HandleError(ctx, c.logger, errorz.ErrForbidden)
-				return
-			}
-		}
+	if !c.authorizeOwner(ctx, booking.UserID) {
+		return
 	}
 
 	// Cancel booking
-	if err := c.service.CancelBooking(types.BinaryUUID(id)); err != nil {
+	if err := c.serviceFor(ctx).CancelBooking(id); err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
@@ -723,55 +794,215 @@ func (c *Controller) CancelBooking(ctx *gin.Context) {
 	ctx.Status(http.StatusNoContent)
 }
 
+// RestoreBooking handles restoring a cancelled booking
+func (c *Controller) RestoreBooking(ctx *gin.Context) {
+	c.logger.Info("[BookingController...RestoreBooking]")
+
+	// Parse ID parameter
+	idParam := ctx.Param("id")
+	id, err := types.ShouldParseUUID(idParam)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	// Get booking to check authorization
+	booking, err := c.serviceFor(ctx).GetBookingByID(id)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Authorization check: user can only restore their own bookings unless they're an admin
+	if !c.authorizeOwner(ctx, booking.UserID) {
+		return
+	}
+
+	if err := c.serviceFor(ctx).RestoreBooking(id); err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Get updated booking
+	booking, err = c.serviceFor(ctx).GetBookingByID(id)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Convert to response DTO
+	dtos, err := c.bookingDTOs(ctx, booking)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[BookingResponseDTO]{
+			Item:    dtos[0],
+			Message: "Booking restored successfully",
+		},
+	)
+}
+
+// MarkBookingNoShow handles marking a booking as no-show, admin only
+func (c *Controller) MarkBookingNoShow(ctx *gin.Context) {
+	c.logger.Info("[BookingController...MarkBookingNoShow]")
+
+	// Only admins can flag no-shows
+	if !c.authorizeAdmin(ctx) {
+		return
+	}
+
+	// Parse ID parameter
+	idParam := ctx.Param("id")
+	parsedID, err := types.ShouldParseUUID(idParam)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	if err := c.serviceFor(ctx).MarkBookingNoShow(parsedID); err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Get updated booking
+	booking, err := c.serviceFor(ctx).GetBookingByID(parsedID)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Convert to response DTO
+	dtos, err := c.bookingDTOs(ctx, booking)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+	response := dtos[0]
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[BookingResponseDTO]{
+			Item:    response,
+			Message: "Booking marked as no-show",
+		},
+	)
+}
+
+// CheckInBooking handles the booking check-in request
+func (c *Controller) CheckInBooking(ctx *gin.Context) {
+	c.logger.Info("[BookingController...CheckInBooking]")
+	c.stampBooking(ctx, c.serviceFor(ctx).CheckInBooking, "Booking checked in successfully")
+}
+
+// CheckOutBooking handles the booking check-out request
+func (c *Controller) CheckOutBooking(ctx *gin.Context) {
+	c.logger.Info("[BookingController...CheckOutBooking]")
+	c.stampBooking(ctx, c.serviceFor(ctx).CheckOutBooking, "Booking checked out successfully")
+}
+
+func (c *Controller) stampBooking(ctx *gin.Context, stamp func(types.BinaryUUID) error, message string) {
+	// Parse ID parameter
+	idParam := ctx.Param("id")
+	parsedID, err := types.ShouldParseUUID(idParam)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	// Get booking to check authorization
+	booking, err := c.serviceFor(ctx).GetBookingByID(parsedID)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Authorization check: user can only check in/out their own bookings unless they're an admin
+	if !c.authorizeOwner(ctx, booking.UserID) {
+		return
+	}
+
+	if err := stamp(parsedID); err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Get updated booking
+	updatedBooking, err := c.serviceFor(ctx).GetBookingByID(parsedID)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Convert to response DTO
+	dtos, err := c.bookingDTOs(ctx, updatedBooking)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+	response := dtos[0]
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[BookingResponseDTO]{
+			Item:    response,
+			Message: message,
+		},
+	)
+}
+
 // ListBookings handles listing bookings with filtering
 func (c *Controller) ListBookings(ctx *gin.Context) {
 	c.logger.Info("[BookingController...ListBookings]")
 
 	// Parse pagination parameters
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
-
-	// Validate pagination parameters
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
+	pagination, err := utils.ParsePagination(ctx)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
 	}
+	page, limit := pagination.Page, pagination.Limit
 
 	// Parse filters
 	filters := make(map[string]interface{})
 
 	// If user is not admin, restrict to their own bookings
-	isAdmin := ctx.GetBool("is_admin") // Assuming this is set by auth middleware
-	if !isAdmin {
-		userIDStr := ctx.GetString("user_id")
-		if userIDStr == "" {
+	identity, err := c.identity(ctx)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+	if !identity.IsAdmin {
+		if !identity.IsAuthenticated() {
 			responses.HandleError(ctx, c.logger, errorz.ErrUnauthorized)
 			return
 		}
 
-		userID, err := uuid.Parse(userIDStr)
-		if err != nil {
-			responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
-			return
-		}
-
-		filters["user_id"] = types.BinaryUUID(userID)
+		filters["user_id"] = identity.UserID
 	} else {
 		// Allow filtering by resource and user for admins
 		if resourceIDStr := ctx.Query("resource_id"); resourceIDStr != "" {
-			resourceID, err := uuid.Parse(resourceIDStr)
-			if err == nil {
-				filters["resource_id"] = types.BinaryUUID(resourceID)
+			resourceID, err := types.ShouldParseUUID(resourceIDStr)
+			if err != nil {
+				responses.HandleValidationError(ctx, c.logger, err)
+				return
 			}
+			filters["resource_id"] = resourceID
 		}
 
 		if userIDStr := ctx.Query("user_id"); userIDStr != "" {
-			userID, err := uuid.Parse(userIDStr)
-			if err == nil {
-				filters["user_id"] = types.BinaryUUID(userID)
+			userID, err := types.ShouldParseUUID(userIDStr)
+			if err != nil {
+				responses.HandleValidationError(ctx, c.logger, err)
+				return
 			}
+			filters["user_id"] = userID
 		}
 	}
 
@@ -781,16 +1012,17 @@ func (c *Controller) ListBookings(ctx *gin.Context) {
 	}
 
 	// Get bookings
-	bookings, total, err := c.service.ListBookings(page, limit, filters)
+	bookings, total, err := c.serviceFor(ctx).ListBookings(page, limit, filters)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
 	// Convert to response format
-	items := make([]BookingResponseDTO, len(bookings))
-	for i, booking := range bookings {
-		items[i] = BookingToDTO(&booking)
+	items, err := c.bookingDTOs(ctx, bookings...)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
 	}
 
 	// Create paginated response
@@ -798,12 +1030,142 @@ func (c *Controller) ListBookings(ctx *gin.Context) {
 		ctx,
 		http.StatusOK,
 		responses.ListResponseType[BookingResponseDTO]{
-			Items:   items,
-			Message: "Bookings retrieved successfully",
-			Pagination: responses.PaginationResponseType{
-				Total:   total,
-				HasNext: int64(page*limit) < total,
-			},
+			Items:      items,
+			Message:    "Bookings retrieved successfully",
+			Pagination: responses.NewPagination(page, limit, total),
+		},
+	)
+}
+
+// GetBookingStats handles counting bookings by status, admin only
+func (c *Controller) GetBookingStats(ctx *gin.Context) {
+	c.logger.Info("[BookingController...GetBookingStats]")
+
+	if !c.authorizeAdmin(ctx) {
+		return
+	}
+
+	// Parse optional date range
+	var query BookingStatsQueryDTO
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	counts, err := c.serviceFor(ctx).GetBookingStats(query.StartTime, query.EndTime)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	response := BookingStatsResponseDTO{
+		ByStatus: counts,
+	}
+	for _, count := range counts {
+		response.Total += count
+	}
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[BookingStatsResponseDTO]{
+			Item:    response,
+			Message: "Booking stats retrieved successfully",
+		},
+	)
+}
+
+// GetBookingAnalytics handles the booking utilization over a date range, admin only
+func (c *Controller) GetBookingAnalytics(ctx *gin.Context) {
+	c.logger.Info("[BookingController...GetBookingAnalytics]")
+
+	if !c.authorizeAdmin(ctx) {
+		return
+	}
+
+	var query BookingAnalyticsQueryDTO
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	// Parse optional resource ID
+	var resourceID *types.BinaryUUID
+	if query.ResourceID != "" {
+		parsedID, err := types.ShouldParseUUID(query.ResourceID)
+		if err != nil {
+			responses.HandleValidationError(ctx, c.logger, err)
+			return
+		}
+		resourceID = &parsedID
+	}
+
+	analytics, err := c.serviceFor(ctx).GetBookingAnalytics(query.From, query.To, resourceID)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[BookingAnalytics]{
+			Item:    analytics,
+			Message: "Booking analytics retrieved successfully",
+		},
+	)
+}
+
+// GetResourceConflicts handles listing the overlapping bookings of a resource, admin only
+func (c *Controller) GetResourceConflicts(ctx *gin.Context) {
+	c.logger.Info("[BookingController...GetResourceConflicts]")
+
+	if !c.authorizeAdmin(ctx) {
+		return
+	}
+
+	// Parse resource ID parameter
+	resourceIDParam := ctx.Param("id")
+	resourceID, err := types.ShouldParseUUID(resourceIDParam)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	var query ResourceConflictsQueryDTO
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	groups, err := c.serviceFor(ctx).GetResourceConflicts(resourceID, query.From, query.To)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	// Convert to response DTOs
+	response := make([]ConflictGroupDTO, len(groups))
+	for i, group := range groups {
+		bookings, err := c.bookingDTOs(ctx, group.Bookings...)
+		if err != nil {
+			responses.HandleError(ctx, c.logger, err)
+			return
+		}
+		response[i] = ConflictGroupDTO{
+			StartTime: group.StartTime,
+			EndTime:   group.EndTime,
+			Seats:     bookedSeats(group.Bookings, types.BinaryUUID{}),
+			Bookings:  bookings,
+		}
+	}
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[[]ConflictGroupDTO]{
+			Item:    response,
+			Message: "Resource conflicts retrieved successfully",
 		},
 	)
 }
@@ -814,60 +1176,116 @@ func (c *Controller) ListUserBookings(ctx *gin.Context) {
 
 	// Parse user ID parameter
 	userIDParam := ctx.Param("id")
-	userID, err := uuid.Parse(userIDParam)
+	userID, err := types.ShouldParseUUID(userIDParam)
 	if err != nil {
-		responses.HandleError(ctx, c.logger, errorz.ErrBadRequest)
+		responses.HandleValidationError(ctx, c.logger, err)
 		return
 	}
 
 	// Authorization check: user can only see their own bookings unless they're an admin
-	requestingUserID := ctx.GetString("user_id")
-	isAdmin := ctx.GetBool("is_admin") // Assuming this is set by auth middleware
-
-	if requestingUserID != userIDParam && !isAdmin {
+	identity, err := c.identity(ctx)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+	if identity.UserID != userID && !identity.IsAdmin {
 		responses.HandleError(ctx, c.logger, errorz.ErrForbidden)
 		return
 	}
 
 	// Parse pagination parameters
-	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
-	limit, _ := strconv.Atoi(ctx.DefaultQuery("limit", "10"))
-
-	// Validate pagination parameters
-	if page < 1 {
-		page = 1
-	}
-	if limit < 1 || limit > 100 {
-		limit = 10
+	pagination, err := utils.ParsePagination(ctx)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
 	}
+	page, limit := pagination.Page, pagination.Limit
 
 	// Get bookings
-	bookings, total, err := c.service.ListBookingsByUserID(types.BinaryUUID(userID), page, limit)
+	bookings, total, err := c.serviceFor(ctx).ListBookingsByUserID(userID, page, limit)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
 	// Convert to response format
-	items := make([]BookingResponseDTO, len(bookings))
-	for i, booking := range bookings {
-		items[i] = BookingToDTO(&booking)
+	items, err := c.bookingDTOs(ctx, bookings...)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
 	}
 
-	// Calculate if there are more pages
-	hasNext := int64(page*limit) < total
-
 	// Create paginated response
 	responses.ListResponse(
 		ctx,
 		http.StatusOK,
 		responses.ListResponseType[BookingResponseDTO]{
-			Items:   items,
-			Message: "Bookings retrieved successfully",
-			Pagination: responses.PaginationResponseType{
-				Total:   total,
-				HasNext: hasNext,
-			},
+			Items:      items,
+			Message:    "Bookings retrieved successfully",
+			Pagination: responses.NewPagination(page, limit, total),
 		},
 	)
 }
+
+// serviceFor returns the service running its queries with the request context, which carries the request timeout
+func (c *Controller) serviceFor(ctx *gin.Context) *Service {
+	return c.service.WithContext(ctx.Request.Context())
+}
+
+// identity returns the signed in user behind the request, anonymous for requests without a cognito uid
+func (c *Controller) identity(ctx *gin.Context) (user.Identity, error) {
+	return c.userService.WithContext(ctx.Request.Context()).GetIdentity(ctx.GetString(framework.UID))
+}
+
+// authorizeAdmin checks the signed in user is an admin, responding with an error otherwise
+func (c *Controller) authorizeAdmin(ctx *gin.Context) bool {
+	identity, err := c.identity(ctx)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return false
+	}
+	if !identity.IsAdmin {
+		responses.HandleError(ctx, c.logger, errorz.ErrForbidden)
+		return false
+	}
+	return true
+}
+
+// authorizeOwner checks the signed in user is the owner or an admin, responding with an error otherwise
+// anonymous requests are rejected as unauthorized
+func (c *Controller) authorizeOwner(ctx *gin.Context, ownerID types.BinaryUUID) bool {
+	identity, err := c.identity(ctx)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return false
+	}
+	if !identity.IsAuthenticated() {
+		responses.HandleError(ctx, c.logger, errorz.ErrUnauthorizedAccess)
+		return false
+	}
+	if identity.UserID != ownerID && !identity.IsAdmin {
+		responses.HandleError(ctx, c.logger, errorz.ErrForbidden)
+		return false
+	}
+	return true
+}
+
+// bookingDTOs converts bookings to response DTOs along with their resource names
+func (c *Controller) bookingDTOs(ctx *gin.Context, bookings ...models.Booking) ([]BookingResponseDTO, error) {
+	resourceIDs := make([]types.BinaryUUID, len(bookings))
+	for i, booking := range bookings {
+		resourceIDs[i] = booking.ResourceID
+	}
+
+	resourceNames, err := c.serviceFor(ctx).GetResourceNames(resourceIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]BookingResponseDTO, len(bookings))
+	for i, booking := range bookings {
+		items[i] = BookingToDTO(&booking)
+		items[i].ResourceName = resourceNames[booking.ResourceID]
+	}
+	return items, nil
+}
@@ -0,0 +1,196 @@
+package booking_test
+
+import (
+	"clean-architecture/domain/booking"
+	"clean-architecture/domain/constants"
+	"clean-architecture/domain/models"
+	"clean-architecture/domain/user"
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/middlewares"
+	"clean-architecture/pkg/types"
+	"clean-architecture/testutil"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/steinfletcher/apitest"
+	"go.uber.org/fx"
+)
+
+var _ = Describe("Domain/Booking/Controller", Ordered, func() {
+	var (
+		bookingController *booking.Controller
+		bookingService    *booking.Service
+		bookingRepo       booking.Repository
+		userService       *user.Service
+		logger            framework.Logger
+		handler           *gin.Engine
+		owned             *models.Booking
+	)
+
+	// cognitoUIDHeader stands in for the cognito auth middleware setting the signed in user
+	const cognitoUIDHeader = "X-Cognito-UID"
+
+	createUser := func(role constants.UserRole) string {
+		cognitoUID := uuid.NewString()
+		Expect(userService.Create(&models.User{
+			CognitoUID: &cognitoUID,
+			Email:      cognitoUID + "@example.com",
+			Role:       role,
+		})).To(Succeed())
+		return cognitoUID
+	}
+
+	var ownerUID, adminUID, otherUID string
+
+	BeforeAll(func() {
+		err := testutil.DI(t,
+			fx.Populate(&bookingController),
+			fx.Populate(&bookingService),
+			fx.Populate(&bookingRepo),
+			fx.Populate(&userService),
+			fx.Populate(&logger),
+		)
+		if err != nil {
+			t.Error(err)
+		}
+
+		ownerUID = createUser("")
+		adminUID = createUser(constants.UserRoleAdmin)
+		otherUID = createUser("")
+
+		owner, err := userService.GetIdentity(ownerUID)
+		Expect(err).To(BeNil())
+
+		resource := &models.Resource{Name: "Authorized Room", Type: "room", Capacity: 1}
+		Expect(bookingService.CreateResource(resource)).To(Succeed())
+
+		start := time.Now().Add(96 * time.Hour).Truncate(time.Hour)
+		owned = &models.Booking{
+			ResourceID: resource.UUID,
+			UserID:     owner.UserID,
+			StartTime:  start,
+			EndTime:    start.Add(time.Hour),
+			Status:     constants.BookingStatusConfirmed,
+		}
+		Expect(bookingRepo.CreateBooking(owned)).To(Succeed())
+
+		handler = gin.New()
+		handler.Use(func(ctx *gin.Context) {
+			if uid := ctx.GetHeader(cognitoUIDHeader); uid != "" {
+				ctx.Set(framework.UID, uid)
+			}
+		})
+		handler.GET("/api/bookings/:id", bookingController.GetBookingByID)
+		handler.GET("/api/bookings/stats", bookingController.GetBookingStats)
+		handler.PUT("/api/bookings/:id", bookingController.UpdateBooking)
+	})
+
+	getBooking := func(cognitoUID string) *apitest.Response {
+		return apitest.
+			New().
+			Handler(handler).
+			Get("/api/bookings/"+owned.UUID.String()).
+			Header(cognitoUIDHeader, cognitoUID).
+			Expect(t)
+	}
+
+	It("should resolve the admin role from the stored user", func() {
+		identity, err := userService.GetIdentity(adminUID)
+		Expect(err).To(BeNil())
+		Expect(identity.IsAdmin).To(BeTrue())
+
+		identity, err = userService.GetIdentity(otherUID)
+		Expect(err).To(BeNil())
+		Expect(identity.IsAdmin).To(BeFalse())
+		Expect(identity.UserID).NotTo(Equal(types.BinaryUUID{}))
+	})
+
+	It("should let the owner fetch their booking", func() {
+		getBooking(ownerUID).Status(http.StatusOK).End()
+	})
+
+	It("should let an admin fetch another user's booking", func() {
+		getBooking(adminUID).Status(http.StatusOK).End()
+	})
+
+	It("should not let a regular user fetch another user's booking", func() {
+		getBooking(otherUID).Status(http.StatusForbidden).End()
+	})
+
+	It("should not let the owner mark their booking as no-show through an update", func() {
+		apitest.
+			New().
+			Handler(handler).
+			Put("/api/bookings/"+owned.UUID.String()).
+			Header(cognitoUIDHeader, ownerUID).
+			JSON(`{"status": "no-show"}`).
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+
+		saved, err := bookingService.GetBookingByID(owned.UUID)
+		Expect(err).To(BeNil())
+		Expect(saved.Status).To(Equal(constants.BookingStatusConfirmed))
+	})
+
+	It("should reject anonymous requests for a booking", func() {
+		getBooking("").Status(http.StatusUnauthorized).End()
+
+		apitest.
+			New().
+			Handler(handler).
+			Put("/api/bookings/" + owned.UUID.String()).
+			JSON(`{"notes": "anonymous update"}`).
+			Expect(t).
+			Status(http.StatusUnauthorized).
+			End()
+	})
+
+	It("should reject users unknown to the user service", func() {
+		getBooking(uuid.NewString()).Status(http.StatusUnauthorized).End()
+	})
+
+	It("should time out booking lookups once the request deadline passes", func() {
+		timedOut := infrastructure.Router{Engine: gin.New()}
+		middlewares.NewTimeoutMiddleware(logger, timedOut, &framework.Env{
+			RequestTimeout: time.Nanosecond,
+		}).Setup()
+		timedOut.GET("/api/bookings/:id", bookingController.GetBookingByID)
+		timedOut.GET("/api/resources", bookingController.ListResources)
+
+		for _, path := range []string{"/api/bookings/" + owned.UUID.String(), "/api/resources"} {
+			apitest.
+				New().
+				Handler(timedOut).
+				Get(path).
+				Expect(t).
+				Status(http.StatusGatewayTimeout).
+				End()
+		}
+	})
+
+	It("should only let admins get booking stats", func() {
+		apitest.
+			New().
+			Handler(handler).
+			Get("/api/bookings/stats").
+			Header(cognitoUIDHeader, adminUID).
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+
+		apitest.
+			New().
+			Handler(handler).
+			Get("/api/bookings/stats").
+			Header(cognitoUIDHeader, otherUID).
+			Expect(t).
+			Status(http.StatusForbidden).
+			End()
+	})
+})
@@ -8,35 +8,42 @@ import (
 
 // ResourceCreateDTO for creating a new resource
 type ResourceCreateDTO struct {
-	Name        string                 `json:"name" binding:"required"`
-	Description string                 `json:"description"`
-	Type        string                 `json:"type" binding:"required"`
-	Capacity    int                    `json:"capacity"`
-	Location    string                 `json:"location"`
-	Attributes  map[string]interface{} `json:"attributes"`
+	Name          string                 `json:"name" binding:"required"`
+	Description   string                 `json:"description"`
+	Type          string                 `json:"type" binding:"required"`
+	Capacity      int                    `json:"capacity"`
+	Location      string                 `json:"location"`
+	Attributes    map[string]interface{} `json:"attributes"`
+	BufferMinutes int                    `json:"buffer_minutes" binding:"min=0"`
+	SeatBased     bool                   `json:"seat_based"`
 }
 
 // ResourceResponseDTO for resource responses
 type ResourceResponseDTO struct {
-	UUID        string                 `json:"id"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description"`
-	Type        string                 `json:"type"`
-	Capacity    int                    `json:"capacity"`
-	Location    string                 `json:"location"`
-	Attributes  map[string]interface{} `json:"attributes"`
-	CreatedAt   time.Time              `json:"created_at"`
-	UpdatedAt   time.Time              `json:"updated_at"`
+	UUID             string                 `json:"id"`
+	Name             string                 `json:"name"`
+	Description      string                 `json:"description"`
+	Type             string                 `json:"type"`
+	Capacity         int                    `json:"capacity"`
+	Location         string                 `json:"location"`
+	Attributes       map[string]interface{} `json:"attributes"`
+	BufferMinutes    int                    `json:"buffer_minutes"`
+	UnderMaintenance bool                   `json:"under_maintenance"`
+	SeatBased        bool                   `json:"seat_based"`
+	CreatedAt        time.Time              `json:"created_at"`
+	UpdatedAt        time.Time              `json:"updated_at"`
 }
 
 // ResourceUpdateDTO for updating a resource
 type ResourceUpdateDTO struct {
-	Name        string                 `json:"name"`
-	Description string                 `json:"description"`
-	Type        string                 `json:"type"`
-	Capacity    int                    `json:"capacity"`
-	Location    string                 `json:"location"`
-	Attributes  map[string]interface{} `json:"attributes"`
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	Type          string                 `json:"type"`
+	Capacity      int                    `json:"capacity"`
+	Location      string                 `json:"location"`
+	Attributes    map[string]interface{} `json:"attributes"`
+	BufferMinutes *int                   `json:"buffer_minutes" binding:"omitempty,min=0"`
+	SeatBased     *bool                  `json:"seat_based"`
 }
 
 // AvailabilityCreateDTO for creating availability
@@ -47,6 +54,16 @@ type AvailabilityCreateDTO struct {
 	RecurRule   string    `json:"recur_rule"`
 }
 
+// AvailabilityGenerateDTO for generating availabilities from a weekly schedule
+// days use the RRULE codes MO to SU and times of day are HH:MM in the timezone of from
+type AvailabilityGenerateDTO struct {
+	DaysOfWeek []string  `json:"days_of_week" binding:"required,min=1"`
+	StartTime  string    `json:"start_time" binding:"required"`
+	EndTime    string    `json:"end_time" binding:"required"`
+	From       time.Time `json:"from" binding:"required"`
+	Until      time.Time `json:"until" binding:"required"`
+}
+
 // AvailabilityResponseDTO for availability responses
 type AvailabilityResponseDTO struct {
 	UUID        string    `json:"id"`
@@ -78,6 +95,18 @@ type AvailabilityCheckResponseDTO struct {
 	Available bool `json:"available"`
 }
 
+// MultipleAvailabilityCheckDTO for checking availability of multiple resources
+type MultipleAvailabilityCheckDTO struct {
+	ResourceIDs []string  `form:"resource_ids" binding:"required"`
+	StartTime   time.Time `form:"start" binding:"required"`
+	EndTime     time.Time `form:"end" binding:"required"`
+}
+
+// MultipleAvailabilityCheckResponseDTO for multiple availability check responses keyed by resource ID
+type MultipleAvailabilityCheckResponseDTO struct {
+	Results map[string]bool `json:"results"`
+}
+
 // BookingCreateDTO for creating a booking
 type BookingCreateDTO struct {
 	ResourceID types.BinaryUUID `json:"resource_id" binding:"required"`
@@ -85,33 +114,86 @@ type BookingCreateDTO struct {
 	EndTime    time.Time        `json:"end_time" binding:"required"`
 	Notes      string           `json:"notes"`
 	Reference  string           `json:"reference"`
+	Seats      int              `json:"seats" binding:"omitempty,min=1"`
 }
 
 // BookingResponseDTO for booking responses
 type BookingResponseDTO struct {
-	UUID       string    `json:"id"`
-	ResourceID string    `json:"resource_id"`
-	UserID     string    `json:"user_id"`
-	StartTime  time.Time `json:"start_time"`
-	EndTime    time.Time `json:"end_time"`
-	Status     string    `json:"status"`
-	Notes      string    `json:"notes"`
-	Reference  string    `json:"reference"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	UUID         string     `json:"id"`
+	ResourceID   string     `json:"resource_id"`
+	ResourceName string     `json:"resource_name"`
+	UserID       string     `json:"user_id"`
+	StartTime    time.Time  `json:"start_time"`
+	EndTime      time.Time  `json:"end_time"`
+	Status       string     `json:"status"`
+	Notes        string     `json:"notes"`
+	Reference    string     `json:"reference"`
+	Seats        int        `json:"seats"`
+	CheckedInAt  *time.Time `json:"checked_in_at"`
+	CheckedOutAt *time.Time `json:"checked_out_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
 }
 
 // BookingUpdateDTO for updating a booking
 type BookingUpdateDTO struct {
 	StartTime time.Time `json:"start_time"`
 	EndTime   time.Time `json:"end_time"`
-	Status    string    `json:"status"`
+	Status    string    `json:"status" binding:"omitempty,oneof=cancelled"`
 	Notes     string    `json:"notes"`
 	Reference string    `json:"reference"`
 }
 
+// BookingStatsQueryDTO for limiting booking stats to bookings starting in a date range
+type BookingStatsQueryDTO struct {
+	StartTime time.Time `form:"start"`
+	EndTime   time.Time `form:"end"`
+}
+
+// BookingStatsResponseDTO for booking counts by status
+type BookingStatsResponseDTO struct {
+	Total    int64            `json:"total"`
+	ByStatus map[string]int64 `json:"by_status"`
+}
+
+// BookingAnalyticsQueryDTO for the utilization of bookings starting in a date range, optionally for one resource
+type BookingAnalyticsQueryDTO struct {
+	From       time.Time `form:"from" binding:"required"`
+	To         time.Time `form:"to" binding:"required"`
+	ResourceID string    `form:"resource_id"`
+}
+
+// BookingDayCount is the number of bookings starting on a day (YYYY-MM-DD, UTC)
+type BookingDayCount struct {
+	Day   string `json:"day"`
+	Count int64  `json:"count"`
+}
+
+// BookingAnalytics for the utilization of resources over a date range
+type BookingAnalytics struct {
+	TotalBookings    int64             `json:"total_bookings"`
+	TotalBookedHours float64           `json:"total_booked_hours"`
+	ByDay            []BookingDayCount `json:"by_day"`
+}
+
+// ResourceConflictsQueryDTO for the window to look for overlapping bookings in
+type ResourceConflictsQueryDTO struct {
+	From time.Time `form:"from" binding:"required"`
+	To   time.Time `form:"to" binding:"required"`
+}
+
+// ConflictGroupDTO for bookings overlapping each other
+// seats is the total taken by the group, which may still fit the resource capacity
+type ConflictGroupDTO struct {
+	StartTime time.Time            `json:"start_time"`
+	EndTime   time.Time            `json:"end_time"`
+	Seats     int                  `json:"seats"`
+	Bookings  []BookingResponseDTO `json:"bookings"`
+}
+
 // ResourceQueryParams for filtering resources
 type ResourceQueryParams struct {
+	Search   string `form:"search"`
 	Type     string `form:"type"`
 	Location string `form:"location"`
 	Capacity int    `form:"capacity"`
@@ -138,15 +220,18 @@ func ResourceToDTO(resource *models.Resource) ResourceResponseDTO {
 	}
 
 	return ResourceResponseDTO{
-		UUID:        resource.UUID.String(),
-		Name:        resource.Name,
-		Description: resource.Description,
-		Type:        resource.Type,
-		Capacity:    resource.Capacity,
-		Location:    resource.Location,
-		Attributes:  attributes,
-		CreatedAt:   resource.CreatedAt,
-		UpdatedAt:   resource.UpdatedAt,
+		UUID:             resource.UUID.String(),
+		Name:             resource.Name,
+		Description:      resource.Description,
+		Type:             resource.Type,
+		Capacity:         resource.Capacity,
+		Location:         resource.Location,
+		Attributes:       attributes,
+		BufferMinutes:    resource.BufferMinutes,
+		UnderMaintenance: resource.UnderMaintenance,
+		SeatBased:        resource.SeatBased,
+		CreatedAt:        resource.CreatedAt,
+		UpdatedAt:        resource.UpdatedAt,
 	}
 }
 
@@ -167,15 +252,18 @@ func AvailabilityToDTO(availability *models.Availability) AvailabilityResponseDT
 // BookingToDTO converts a Booking model to BookingResponseDTO
 func BookingToDTO(booking *models.Booking) BookingResponseDTO {
 	return BookingResponseDTO{
-		UUID:       booking.UUID.String(),
-		ResourceID: booking.ResourceID.String(),
-		UserID:     booking.UserID.String(),
-		StartTime:  booking.StartTime,
-		EndTime:    booking.EndTime,
-		Status:     booking.Status,
-		Notes:      booking.Notes,
-		Reference:  booking.Reference,
-		CreatedAt:  booking.CreatedAt,
-		UpdatedAt:  booking.UpdatedAt,
+		UUID:         booking.UUID.String(),
+		ResourceID:   booking.ResourceID.String(),
+		UserID:       booking.UserID.String(),
+		StartTime:    booking.StartTime,
+		EndTime:      booking.EndTime,
+		Status:       booking.Status,
+		Notes:        booking.Notes,
+		Reference:    booking.Reference,
+		Seats:        booking.Seats,
+		CheckedInAt:  booking.CheckedInAt,
+		CheckedOutAt: booking.CheckedOutAt,
+		CreatedAt:    booking.CreatedAt,
+		UpdatedAt:    booking.UpdatedAt,
 	}
 }
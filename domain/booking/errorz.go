@@ -14,6 +14,16 @@ const (
 	ErrCodePastDateBooking      = "PAST_DATE_BOOKING"
 	ErrCodeExceedsMaxDuration   = "EXCEEDS_MAX_DURATION"
 	ErrCodeInsufficientLeadTime = "INSUFFICIENT_LEAD_TIME"
+	ErrCodeInvalidRecurRule     = "INVALID_RECUR_RULE"
+	ErrCodeAvailabilityOverlap  = "AVAILABILITY_OVERLAP"
+	ErrCodeUnderMaintenance     = "RESOURCE_UNDER_MAINTENANCE"
+	ErrCodeAlreadyCheckedIn     = "ALREADY_CHECKED_IN"
+	ErrCodeAlreadyCheckedOut    = "ALREADY_CHECKED_OUT"
+	ErrCodeNotCheckedIn         = "NOT_CHECKED_IN"
+	ErrCodeInvalidAttributes    = "INVALID_RESOURCE_ATTRIBUTES"
+	ErrCodeDuplicateReference   = "DUPLICATE_BOOKING_REFERENCE"
+	ErrCodeExceedsCapacity      = "EXCEEDS_RESOURCE_CAPACITY"
+	ErrCodeInvalidSchedule      = "INVALID_AVAILABILITY_SCHEDULE"
 )
 
 var (
@@ -46,4 +56,34 @@ var (
 
 	// ErrInsufficientLeadTime is returned when a booking doesn't meet the minimum lead time requirement
 	ErrInsufficientLeadTime = errorz.ErrBadRequest.JoinError("booking does not meet minimum lead time requirement")
+
+	// ErrInvalidRecurRule is returned when a recurring availability has a malformed recurrence rule
+	ErrInvalidRecurRule = errorz.ErrBadRequest.JoinError("invalid recurrence rule")
+
+	// ErrAvailabilityOverlap is returned when an availability overlaps with existing availabilities of the resource
+	ErrAvailabilityOverlap = errorz.ErrConflict.JoinError("availability overlaps with existing availabilities")
+
+	// ErrResourceUnderMaintenance is returned when booking a resource that is under maintenance
+	ErrResourceUnderMaintenance = errorz.ErrConflict.JoinError("resource is under maintenance")
+
+	// ErrAlreadyCheckedIn is returned when checking in a booking twice
+	ErrAlreadyCheckedIn = errorz.ErrConflict.JoinError("booking already checked in")
+
+	// ErrAlreadyCheckedOut is returned when checking out a booking twice
+	ErrAlreadyCheckedOut = errorz.ErrConflict.JoinError("booking already checked out")
+
+	// ErrNotCheckedIn is returned when checking out a booking that was never checked in
+	ErrNotCheckedIn = errorz.ErrBadRequest.JoinError("booking has not been checked in")
+
+	// ErrInvalidResourceAttributes is returned when resource attributes don't match the schema of its type
+	ErrInvalidResourceAttributes = errorz.ErrBadRequest.JoinError("invalid resource attributes")
+
+	// ErrDuplicateBookingReference is returned when a provided booking reference is already in use
+	ErrDuplicateBookingReference = errorz.ErrConflict.JoinError("booking reference already in use")
+
+	// ErrExceedsCapacity is returned when a booking requests more seats than the resource has
+	ErrExceedsCapacity = errorz.ErrBadRequest.JoinError("booking exceeds resource capacity")
+
+	// ErrInvalidAvailabilitySchedule is returned when generating availabilities from a malformed weekly schedule
+	ErrInvalidAvailabilitySchedule = errorz.ErrBadRequest.JoinError("invalid availability schedule")
 )
@@ -1,12 +1,18 @@
 package booking
 
 import (
+	"context"
+	"slices"
 	"time"
 
+	"clean-architecture/domain/constants"
 	"clean-architecture/domain/models"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
 	"clean-architecture/pkg/types"
+	"clean-architecture/pkg/utils"
+
+	"gorm.io/gorm"
 )
 
 // Repository handles database operations for resources, availability, and bookings
@@ -20,6 +26,13 @@ func NewRepository(db infrastructure.Database, logger framework.Logger) Reposito
 	return Repository{db, logger}
 }
 
+// WithContext returns a copy of the repository running its queries with the context,
+// so they are cancelled together with the request
+func (r Repository) WithContext(ctx context.Context) Repository {
+	r.Database.DB = r.DB.WithContext(ctx)
+	return r
+}
+
 // -------------- Resource Repository Methods --------------
 
 // CreateResource adds a new resource to the database
@@ -42,6 +55,22 @@ func (r Repository) UpdateResource(resource *models.Resource) error {
 	return r.DB.Save(resource).Error
 }
 
+// GetResourceNames returns the names of resources by UUID, including soft deleted resources
+func (r Repository) GetResourceNames(ids []types.BinaryUUID) (map[types.BinaryUUID]string, error) {
+	r.logger.Info("[BookingRepository...GetResourceNames]")
+	names := make(map[types.BinaryUUID]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	var resources []models.Resource
+	err := r.DB.Unscoped().Select("uuid", "name").Where("uuid IN ?", ids).Find(&resources).Error
+	for _, resource := range resources {
+		names[resource.UUID] = resource.Name
+	}
+	return names, err
+}
+
 // DeleteResource deletes a resource
 func (r Repository) DeleteResource(id types.BinaryUUID) error {
 	r.logger.Info("[BookingRepository...DeleteResource]")
@@ -49,7 +78,8 @@ func (r Repository) DeleteResource(id types.BinaryUUID) error {
 }
 
 // ListResources returns resources with pagination and filtering
-func (r Repository) ListResources(page, limit int, filters map[string]interface{}) ([]models.Resource, int64, error) {
+// search matches part of the name or description when not empty
+func (r Repository) ListResources(page, limit int, search string, filters map[string]interface{}) ([]models.Resource, int64, error) {
 	r.logger.Info("[BookingRepository...ListResources]")
 	var resources []models.Resource
 	var total int64
@@ -63,6 +93,12 @@ func (r Repository) ListResources(page, limit int, filters map[string]interface{
 		}
 	}
 
+	// Apply search on name and description, matching the term literally
+	if search != "" {
+		pattern := utils.ContainsPattern(search)
+		query = query.Where("(name LIKE ? ESCAPE ? OR description LIKE ? ESCAPE ?)", pattern, utils.LikeEscape, pattern, utils.LikeEscape)
+	}
+
 	// Get total count
 	if err := query.Model(&models.Resource{}).Count(&total).Error; err != nil {
 		return nil, 0, err
@@ -83,6 +119,12 @@ func (r Repository) CreateAvailability(availability *models.Availability) error
 	return r.DB.Create(availability).Error
 }
 
+// CreateAvailabilities creates several availabilities at once
+func (r Repository) CreateAvailabilities(availabilities []models.Availability) error {
+	r.logger.Info("[BookingRepository...CreateAvailabilities]")
+	return r.DB.Create(&availabilities).Error
+}
+
 // GetAvailabilityByID retrieves an availability by ID
 func (r Repository) GetAvailabilityByID(id types.BinaryUUID) (models.Availability, error) {
 	r.logger.Info("[BookingRepository...GetAvailabilityByID]")
@@ -103,33 +145,155 @@ func (r Repository) DeleteAvailability(id types.BinaryUUID) error {
 	return r.DB.Where("uuid = ?", id).Delete(&models.Availability{}).Error
 }
 
-// ListAvailabilitiesByResourceID returns availabilities for a resource
-func (r Repository) ListAvailabilitiesByResourceID(resourceID types.BinaryUUID) ([]models.Availability, error) {
+// ListAvailabilitiesByResourceID returns availabilities for a resource with pagination
+func (r Repository) ListAvailabilitiesByResourceID(resourceID types.BinaryUUID, page, limit int) ([]models.Availability, int64, error) {
 	r.logger.Info("[BookingRepository...ListAvailabilitiesByResourceID]")
 	var availabilities []models.Availability
-	err := r.DB.Where("resource_id = ?", resourceID).Find(&availabilities).Error
-	return availabilities, err
+	var total int64
+
+	// Get total count
+	if err := r.DB.Model(&models.Availability{}).Where("resource_id = ?", resourceID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	// Apply pagination
+	offset := (page - 1) * limit
+	err := r.DB.Where("resource_id = ?", resourceID).
+		Offset(offset).
+		Limit(limit).
+		Order("start_time ASC").
+		Find(&availabilities).Error
+
+	return availabilities, total, err
+}
+
+// FindOverlappingAvailabilities finds availabilities of a resource that overlap with a time range,
+// including the later occurrences of recurring availabilities
+// Windows that only touch the time range at its edges are not considered overlapping
+func (r Repository) FindOverlappingAvailabilities(resourceID types.BinaryUUID, start, end time.Time) ([]models.Availability, error) {
+	r.logger.Info("[BookingRepository...FindOverlappingAvailabilities]")
+
+	// Get the windows overlapping the time range, and the recurring ones started before it
+	var candidates []models.Availability
+	err := r.DB.Where("resource_id = ? AND start_time < ? AND (end_time > ? OR is_recurring = ?)", resourceID, end, start, true).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	window := models.Availability{StartTime: start, EndTime: end}
+	var availabilities []models.Availability
+	for _, candidate := range candidates {
+		if availabilitiesOverlap(window, candidate, end) {
+			availabilities = append(availabilities, candidate)
+		}
+	}
+	return availabilities, nil
 }
 
 // IsAvailable checks if a resource is available for a specific time period
+// The period may be covered by a single availability window or by several adjacent ones,
+// including the later occurrences of recurring availabilities
 func (r Repository) IsAvailable(resourceID types.BinaryUUID, start, end time.Time) (bool, error) {
 	r.logger.Info("[BookingRepository...IsAvailable]")
 
-	// Check for any availability windows that cover the requested time
-	var count int64
-	err := r.DB.Model(&models.Availability{}).
-		Where("resource_id = ? AND start_time <= ? AND end_time >= ?", resourceID, start, end).
-		Count(&count).Error
+	// Get all availability windows overlapping the requested time, and the recurring ones started before it
+	var availabilities []models.Availability
+	err := r.DB.Where("resource_id = ? AND start_time <= ? AND (end_time >= ? OR is_recurring = ?)", resourceID, end, start, true).
+		Find(&availabilities).Error
+	if err != nil {
+		return false, err
+	}
 
-	return count > 0, err
+	return coversTimeRange(expandAvailabilities(availabilities, start, end), start, end), nil
+}
+
+// expandAvailabilities adds the occurrences of recurring availabilities overlapping the time range to the windows,
+// sorted by start time. The stored window of an availability always counts, even when the rule is invalid
+func expandAvailabilities(availabilities []models.Availability, start, end time.Time) []models.Availability {
+	var windows []models.Availability
+	for _, availability := range availabilities {
+		if !availability.StartTime.After(end) && !availability.EndTime.Before(start) {
+			windows = append(windows, availability)
+		}
+		if !availability.IsRecurring {
+			continue
+		}
+
+		option, err := parseRRULE(availability.RecurRule)
+		if err != nil {
+			continue
+		}
+		duration := availability.EndTime.Sub(availability.StartTime)
+		occurrences, err := expandRecurrence(option, availability.StartTime, start.Add(-duration), end)
+		if err != nil {
+			continue
+		}
+		for _, occurrence := range occurrences {
+			window := availability
+			window.StartTime = occurrence
+			window.EndTime = occurrence.Add(duration)
+			windows = append(windows, window)
+		}
+	}
+
+	slices.SortFunc(windows, func(a, b models.Availability) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	return windows
+}
+
+// availabilitiesOverlap checks if a window of a overlaps a window of b between the start of a and until,
+// comparing the occurrences of recurring availabilities. Windows only touching at their edges don't overlap
+func availabilitiesOverlap(a, b models.Availability, until time.Time) bool {
+	windowsA := expandAvailabilities([]models.Availability{a}, a.StartTime, until)
+	windowsB := expandAvailabilities([]models.Availability{b}, a.StartTime, until)
+
+	// the windows of an availability share their duration, so sorted by start they are sorted by end too
+	i, j := 0, 0
+	for i < len(windowsA) && j < len(windowsB) {
+		switch {
+		case !windowsA[i].EndTime.After(windowsB[j].StartTime):
+			i++
+		case !windowsB[j].EndTime.After(windowsA[i].StartTime):
+			j++
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// coversTimeRange checks if the union of the availability windows covers the whole time range without gaps
+// the windows must be sorted by start time
+func coversTimeRange(availabilities []models.Availability, start, end time.Time) bool {
+	covered := start
+	for _, availability := range availabilities {
+		if availability.StartTime.After(covered) {
+			// there is a gap before this window
+			return false
+		}
+		if availability.EndTime.After(covered) {
+			covered = availability.EndTime
+		}
+		if !covered.Before(end) {
+			return true
+		}
+	}
+	return false
 }
 
 // -------------- Booking Repository Methods --------------
 
 // CreateBooking adds a new booking to the database
+// unique violations are returned as gorm.ErrDuplicatedKey
 func (r Repository) CreateBooking(booking *models.Booking) error {
 	r.logger.Info("[BookingRepository...CreateBooking]")
-	return r.DB.Create(booking).Error
+	err := r.DB.Create(booking).Error
+	if translator, ok := r.DB.Dialector.(gorm.ErrorTranslator); ok && err != nil {
+		return translator.Translate(err)
+	}
+	return err
 }
 
 // GetBookingByID retrieves a booking by ID
@@ -140,6 +304,14 @@ func (r Repository) GetBookingByID(id types.BinaryUUID) (models.Booking, error)
 	return booking, err
 }
 
+// BookingReferenceExists checks if a booking already uses the reference
+func (r Repository) BookingReferenceExists(reference string) (bool, error) {
+	r.logger.Info("[BookingRepository...BookingReferenceExists]")
+	var count int64
+	err := r.DB.Model(&models.Booking{}).Where("reference = ?", reference).Count(&count).Error
+	return count > 0, err
+}
+
 // UpdateBooking updates a booking
 func (r Repository) UpdateBooking(booking *models.Booking) error {
 	r.logger.Info("[BookingRepository...UpdateBooking]")
@@ -150,7 +322,7 @@ func (r Repository) UpdateBooking(booking *models.Booking) error {
 func (r Repository) DeleteBooking(id types.BinaryUUID) error {
 	r.logger.Info("[BookingRepository...DeleteBooking]")
 	// Soft delete for bookings
-	return r.DB.Model(&models.Booking{}).Where("uuid = ?", id).Update("status", "cancelled").Error
+	return r.DB.Model(&models.Booking{}).Where("uuid = ?", id).Update("status", constants.BookingStatusCancelled).Error
 }
 
 // ListBookings returns bookings with pagination and filtering
@@ -180,15 +352,84 @@ func (r Repository) ListBookings(page, limit int, filters map[string]interface{}
 	return bookings, total, err
 }
 
+// CountBookingsByStatus counts bookings grouped by status
+// start and end limit the bookings to those starting in the range when not zero
+func (r Repository) CountBookingsByStatus(start, end time.Time) (map[string]int64, error) {
+	r.logger.Info("[BookingRepository...CountBookingsByStatus]")
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+
+	query := r.DB.Model(&models.Booking{})
+	if !start.IsZero() {
+		query = query.Where("start_time >= ?", start)
+	}
+	if !end.IsZero() {
+		query = query.Where("start_time < ?", end)
+	}
+
+	err := query.Select("status, COUNT(*) AS count").Group("status").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// bookingAnalyticsScope limits bookings to the utilizing ones starting in [start, end), for one resource when given
+func bookingAnalyticsScope(start, end time.Time, resourceID *types.BinaryUUID) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("start_time >= ? AND start_time < ? AND status IN ?", start, end, constants.BookingUtilizingStatuses)
+		if resourceID != nil {
+			db = db.Where("resource_id = ?", *resourceID)
+		}
+		return db
+	}
+}
+
+// ListAnalyticsBookings returns the start and end times of the bookings starting in [start, end), ordered by start time
+func (r Repository) ListAnalyticsBookings(start, end time.Time, resourceID *types.BinaryUUID) ([]models.Booking, error) {
+	r.logger.Info("[BookingRepository...ListAnalyticsBookings]")
+	var bookings []models.Booking
+
+	err := r.DB.Model(&models.Booking{}).
+		Scopes(bookingAnalyticsScope(start, end, resourceID)).
+		Select("start_time", "end_time").
+		Order("start_time ASC").
+		Find(&bookings).Error
+	return bookings, err
+}
+
 // FindOverlappingBookings finds bookings that overlap with a time range for a resource
+// Only bookings in an occupying status (pending or confirmed) are considered,
+// cancelled and completed bookings don't block the time range.
+// Bookings that only touch the time range at its edges are not considered overlapping
 func (r Repository) FindOverlappingBookings(resourceID types.BinaryUUID, start, end time.Time) ([]models.Booking, error) {
 	r.logger.Info("[BookingRepository...FindOverlappingBookings]")
 	var bookings []models.Booking
 
 	// Time range overlap query
-	// (StartA <= EndB) AND (EndA >= StartB)
-	err := r.DB.Where("resource_id = ? AND start_time <= ? AND end_time >= ? AND status != 'cancelled'",
-		resourceID, end, start).Find(&bookings).Error
+	// (StartA < EndB) AND (EndA > StartB)
+	err := r.DB.Where("resource_id = ? AND start_time < ? AND end_time > ? AND status IN ?",
+		resourceID, end, start, constants.BookingOccupyingStatuses).Find(&bookings).Error
+
+	return bookings, err
+}
+
+// ListResourceBookingsInRange returns the bookings of a resource holding it within [start, end), ordered by start time
+func (r Repository) ListResourceBookingsInRange(resourceID types.BinaryUUID, start, end time.Time) ([]models.Booking, error) {
+	r.logger.Info("[BookingRepository...ListResourceBookingsInRange]")
+	var bookings []models.Booking
+
+	err := r.DB.Where("resource_id = ? AND start_time < ? AND end_time > ? AND status IN ?",
+		resourceID, end, start, constants.BookingOccupyingStatuses).
+		Order("start_time ASC").
+		Find(&bookings).Error
 
 	return bookings, err
 }
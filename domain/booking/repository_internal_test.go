@@ -0,0 +1,151 @@
+package booking
+
+import (
+	"clean-architecture/domain/models"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Domain/Booking/Repository/coversTimeRange", func() {
+	day := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time {
+		return day.Add(time.Duration(hour) * time.Hour)
+	}
+	window := func(from, to int) models.Availability {
+		return models.Availability{StartTime: at(from), EndTime: at(to)}
+	}
+
+	It("should be available within a single window", func() {
+		windows := []models.Availability{window(9, 17)}
+
+		Expect(coversTimeRange(windows, at(10), at(12))).To(BeTrue())
+		Expect(coversTimeRange(windows, at(9), at(17))).To(BeTrue())
+	})
+
+	It("should be available across two adjacent windows", func() {
+		windows := []models.Availability{window(9, 12), window(12, 15)}
+
+		Expect(coversTimeRange(windows, at(11), at(13))).To(BeTrue())
+		Expect(coversTimeRange(windows, at(9), at(15))).To(BeTrue())
+	})
+
+	It("should be available across overlapping windows", func() {
+		windows := []models.Availability{window(9, 13), window(11, 15)}
+
+		Expect(coversTimeRange(windows, at(10), at(14))).To(BeTrue())
+	})
+
+	It("should not be available when windows have a gap", func() {
+		windows := []models.Availability{window(9, 11), window(12, 15)}
+
+		Expect(coversTimeRange(windows, at(10), at(13))).To(BeFalse())
+	})
+
+	It("should not be available outside the windows", func() {
+		windows := []models.Availability{window(9, 12)}
+
+		Expect(coversTimeRange(windows, at(8), at(10))).To(BeFalse())
+		Expect(coversTimeRange(windows, at(11), at(13))).To(BeFalse())
+		Expect(coversTimeRange(nil, at(11), at(13))).To(BeFalse())
+	})
+})
+
+var _ = Describe("Domain/Booking/Repository/expandAvailabilities", func() {
+	// 2030-01-07 is a Monday
+	monday := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	at := func(days, hour int) time.Time {
+		return monday.AddDate(0, 0, days).Add(time.Duration(hour) * time.Hour)
+	}
+	weekdays := models.Availability{
+		StartTime:   at(0, 9),
+		EndTime:     at(0, 17),
+		IsRecurring: true,
+		RecurRule:   "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+	}
+
+	It("should cover later occurrences of a recurring availability", func() {
+		windows := expandAvailabilities([]models.Availability{weekdays}, at(9, 10), at(9, 12))
+
+		Expect(windows).To(HaveLen(1))
+		Expect(windows[0].StartTime).To(Equal(at(9, 9)))
+		Expect(coversTimeRange(windows, at(9, 10), at(9, 12))).To(BeTrue())
+	})
+
+	It("should not cover days outside the rule", func() {
+		windows := expandAvailabilities([]models.Availability{weekdays}, at(5, 10), at(5, 12))
+
+		Expect(windows).To(BeEmpty())
+		Expect(coversTimeRange(windows, at(5, 10), at(5, 12))).To(BeFalse())
+	})
+
+	It("should keep non recurring and invalid recurring availabilities as stored", func() {
+		invalid := weekdays
+		invalid.RecurRule = "FREQ=SOMETIMES"
+		single := models.Availability{StartTime: at(1, 17), EndTime: at(1, 19)}
+
+		windows := expandAvailabilities([]models.Availability{single, invalid}, at(0, 8), at(1, 20))
+
+		Expect(windows).To(HaveLen(2))
+		Expect(windows[0].StartTime).To(Equal(at(0, 9)))
+		Expect(windows[1].StartTime).To(Equal(at(1, 17)))
+	})
+})
+
+var _ = Describe("Domain/Booking/Repository/availabilitiesOverlap", func() {
+	// 2030-01-07 is a Monday
+	monday := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+	at := func(days, hour int) time.Time {
+		return monday.AddDate(0, 0, days).Add(time.Duration(hour) * time.Hour)
+	}
+	// recurring windows start on the first day of their rule, counting days from monday
+	weekly := func(rule string, day, from, to int) models.Availability {
+		return models.Availability{StartTime: at(day, from), EndTime: at(day, to), IsRecurring: true, RecurRule: rule}
+	}
+	until := at(28, 0)
+
+	It("should detect a one-off window on a later occurrence of a recurring window", func() {
+		single := models.Availability{StartTime: at(14, 10), EndTime: at(14, 12)}
+
+		Expect(availabilitiesOverlap(single, weekly("FREQ=WEEKLY;BYDAY=MO", 0, 9, 17), single.EndTime)).To(BeTrue())
+		Expect(availabilitiesOverlap(single, weekly("FREQ=WEEKLY;BYDAY=TU", 1, 9, 17), single.EndTime)).To(BeFalse())
+	})
+
+	It("should not treat weekly rules on different days as overlapping", func() {
+		Expect(availabilitiesOverlap(
+			weekly("FREQ=WEEKLY;BYDAY=TU,TH", 1, 9, 17),
+			weekly("FREQ=WEEKLY;BYDAY=MO,WE", 0, 9, 17),
+			until,
+		)).To(BeFalse())
+	})
+
+	It("should detect recurring windows sharing a day", func() {
+		Expect(availabilitiesOverlap(
+			weekly("FREQ=WEEKLY;BYDAY=WE", 2, 9, 17),
+			weekly("FREQ=WEEKLY;BYDAY=MO,WE", 0, 9, 17),
+			until,
+		)).To(BeTrue())
+	})
+
+	It("should compare recurring windows by time of day, whatever their frequency", func() {
+		Expect(availabilitiesOverlap(
+			weekly("FREQ=DAILY", 0, 18, 20),
+			weekly("FREQ=WEEKLY;BYDAY=MO,WE", 0, 9, 17),
+			until,
+		)).To(BeFalse())
+		Expect(availabilitiesOverlap(
+			weekly("FREQ=DAILY", 0, 16, 18),
+			weekly("FREQ=WEEKLY;BYDAY=MO,WE", 0, 9, 17),
+			until,
+		)).To(BeTrue())
+	})
+
+	It("should not treat windows only touching at their edges as overlapping", func() {
+		Expect(availabilitiesOverlap(
+			weekly("FREQ=WEEKLY;BYDAY=MO", 0, 17, 19),
+			weekly("FREQ=WEEKLY;BYDAY=MO", 0, 9, 17),
+			until,
+		)).To(BeFalse())
+	})
+})
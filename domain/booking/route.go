@@ -3,6 +3,9 @@ package booking
 import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/openapi"
+	"clean-architecture/pkg/responses"
+	"net/http"
 )
 
 // Route structure for booking system
@@ -10,6 +13,7 @@ type Route struct {
 	logger     framework.Logger
 	handler    infrastructure.Router
 	controller *Controller
+	spec       *openapi.Spec
 }
 
 // NewRoute initializes booking routes
@@ -17,11 +21,13 @@ func NewRoute(
 	logger framework.Logger,
 	handler infrastructure.Router,
 	controller *Controller,
+	spec *openapi.Spec,
 ) *Route {
 	return &Route{
 		logger:     logger,
 		handler:    handler,
 		controller: controller,
+		spec:       spec,
 	}
 }
 
@@ -41,9 +47,15 @@ func RegisterRoute(r *Route) {
 		resources.PUT("/:id", r.controller.UpdateResource)
 		resources.DELETE("/:id", r.controller.DeleteResource)
 
+		// Resource maintenance endpoints
+		resources.POST("/:id/maintenance", r.controller.StartResourceMaintenance)
+		resources.DELETE("/:id/maintenance", r.controller.EndResourceMaintenance)
+
 		// Resource availability endpoints
 		resources.GET("/:id/availability", r.controller.CheckResourceAvailability)
 		resources.POST("/:id/availability", r.controller.CreateAvailability)
+		resources.POST("/:id/availability/generate", r.controller.GenerateAvailabilities)
+		resources.GET("/:id/conflicts", r.controller.GetResourceConflicts)
 		resources.GET("/:id/availabilities", r.controller.ListResourceAvailabilities)
 	}
 
@@ -55,11 +67,167 @@ func RegisterRoute(r *Route) {
 	{
 		bookings.POST("", r.controller.CreateBooking)
 		bookings.GET("", r.controller.ListBookings)
+		bookings.GET("/stats", r.controller.GetBookingStats)
+		bookings.GET("/analytics", r.controller.GetBookingAnalytics)
 		bookings.GET("/:id", r.controller.GetBookingByID)
 		bookings.PUT("/:id", r.controller.UpdateBooking)
 		bookings.DELETE("/:id", r.controller.CancelBooking)
+		bookings.POST("/:id/restore", r.controller.RestoreBooking)
+		bookings.POST("/:id/check-in", r.controller.CheckInBooking)
+		bookings.POST("/:id/check-out", r.controller.CheckOutBooking)
+		bookings.POST("/:id/no-show", r.controller.MarkBookingNoShow)
 	}
 
 	// User bookings endpoint
 	api.GET("/users/:id/bookings", r.controller.ListUserBookings)
+
+	describeRoutes(r.spec)
+}
+
+// describeRoutes documents the booking routes in the openapi spec
+func describeRoutes(spec *openapi.Spec) {
+	spec.Describe(http.MethodPost, "/api/resources", openapi.Operation{
+		Summary:  "Create resource",
+		Tag:      "booking",
+		Request:  ResourceCreateDTO{},
+		Response: responses.DetailResponseType[ResourceResponseDTO]{},
+		Status:   http.StatusCreated,
+	})
+	spec.Describe(http.MethodGet, "/api/resources", openapi.Operation{
+		Summary:  "List resources",
+		Tag:      "booking",
+		Query:    ResourceQueryParams{},
+		Response: responses.ListResponseType[ResourceResponseDTO]{},
+	})
+	spec.Describe(http.MethodGet, "/api/resources/:id", openapi.Operation{
+		Summary:  "Get resource",
+		Tag:      "booking",
+		Response: responses.DetailResponseType[ResourceResponseDTO]{},
+	})
+	spec.Describe(http.MethodPut, "/api/resources/:id", openapi.Operation{
+		Summary:  "Update resource",
+		Tag:      "booking",
+		Request:  ResourceUpdateDTO{},
+		Response: responses.DetailResponseType[ResourceResponseDTO]{},
+	})
+	spec.Describe(http.MethodDelete, "/api/resources/:id", openapi.Operation{
+		Summary: "Delete resource",
+		Tag:     "booking",
+		Status:  http.StatusNoContent,
+	})
+	spec.Describe(http.MethodPost, "/api/resources/:id/maintenance", openapi.Operation{
+		Summary:  "Start resource maintenance",
+		Tag:      "booking",
+		Response: responses.DetailResponseType[ResourceResponseDTO]{},
+	})
+	spec.Describe(http.MethodDelete, "/api/resources/:id/maintenance", openapi.Operation{
+		Summary:  "End resource maintenance",
+		Tag:      "booking",
+		Response: responses.DetailResponseType[ResourceResponseDTO]{},
+	})
+	spec.Describe(http.MethodGet, "/api/resources/:id/availability", openapi.Operation{
+		Summary:  "Check resource availability",
+		Tag:      "booking",
+		Query:    AvailabilityCheckDTO{},
+		Response: responses.DetailResponseType[AvailabilityCheckResponseDTO]{},
+	})
+	spec.Describe(http.MethodPost, "/api/resources/:id/availability", openapi.Operation{
+		Summary:  "Create availability",
+		Tag:      "booking",
+		Request:  AvailabilityCreateDTO{},
+		Response: responses.DetailResponseType[AvailabilityResponseDTO]{},
+		Status:   http.StatusCreated,
+	})
+	spec.Describe(http.MethodPost, "/api/resources/:id/availability/generate", openapi.Operation{
+		Summary:  "Generate weekly availabilities",
+		Tag:      "booking",
+		Request:  AvailabilityGenerateDTO{},
+		Response: responses.DetailResponseType[[]AvailabilityResponseDTO]{},
+		Status:   http.StatusCreated,
+	})
+	spec.Describe(http.MethodGet, "/api/resources/:id/conflicts", openapi.Operation{
+		Summary:  "List overlapping bookings of a resource",
+		Tag:      "booking",
+		Query:    ResourceConflictsQueryDTO{},
+		Response: responses.DetailResponseType[[]ConflictGroupDTO]{},
+	})
+	spec.Describe(http.MethodGet, "/api/resources/:id/availabilities", openapi.Operation{
+		Summary:  "List resource availabilities",
+		Tag:      "booking",
+		Query:    openapi.PaginationQuery{},
+		Response: responses.ListResponseType[AvailabilityResponseDTO]{},
+	})
+	spec.Describe(http.MethodGet, "/api/availability", openapi.Operation{
+		Summary:  "Check availability of multiple resources",
+		Tag:      "booking",
+		Query:    MultipleAvailabilityCheckDTO{},
+		Response: responses.DetailResponseType[MultipleAvailabilityCheckResponseDTO]{},
+	})
+	spec.Describe(http.MethodPost, "/api/bookings", openapi.Operation{
+		Summary:  "Create booking",
+		Tag:      "booking",
+		Request:  BookingCreateDTO{},
+		Response: responses.DetailResponseType[BookingResponseDTO]{},
+		Status:   http.StatusCreated,
+	})
+	spec.Describe(http.MethodGet, "/api/bookings", openapi.Operation{
+		Summary:  "List bookings",
+		Tag:      "booking",
+		Query:    BookingQueryParams{},
+		Response: responses.ListResponseType[BookingResponseDTO]{},
+	})
+	spec.Describe(http.MethodGet, "/api/bookings/stats", openapi.Operation{
+		Summary:  "Count bookings by status",
+		Tag:      "booking",
+		Query:    BookingStatsQueryDTO{},
+		Response: responses.DetailResponseType[BookingStatsResponseDTO]{},
+	})
+	spec.Describe(http.MethodGet, "/api/bookings/analytics", openapi.Operation{
+		Summary:  "Get booking utilization over a date range",
+		Tag:      "booking",
+		Query:    BookingAnalyticsQueryDTO{},
+		Response: responses.DetailResponseType[BookingAnalytics]{},
+	})
+	spec.Describe(http.MethodGet, "/api/bookings/:id", openapi.Operation{
+		Summary:  "Get booking",
+		Tag:      "booking",
+		Response: responses.DetailResponseType[BookingResponseDTO]{},
+	})
+	spec.Describe(http.MethodPut, "/api/bookings/:id", openapi.Operation{
+		Summary:  "Update booking",
+		Tag:      "booking",
+		Request:  BookingUpdateDTO{},
+		Response: responses.DetailResponseType[BookingResponseDTO]{},
+	})
+	spec.Describe(http.MethodDelete, "/api/bookings/:id", openapi.Operation{
+		Summary: "Cancel booking",
+		Tag:     "booking",
+		Status:  http.StatusNoContent,
+	})
+	spec.Describe(http.MethodPost, "/api/bookings/:id/restore", openapi.Operation{
+		Summary:  "Restore cancelled booking",
+		Tag:      "booking",
+		Response: responses.DetailResponseType[BookingResponseDTO]{},
+	})
+	spec.Describe(http.MethodPost, "/api/bookings/:id/check-in", openapi.Operation{
+		Summary:  "Check in booking",
+		Tag:      "booking",
+		Response: responses.DetailResponseType[BookingResponseDTO]{},
+	})
+	spec.Describe(http.MethodPost, "/api/bookings/:id/check-out", openapi.Operation{
+		Summary:  "Check out booking",
+		Tag:      "booking",
+		Response: responses.DetailResponseType[BookingResponseDTO]{},
+	})
+	spec.Describe(http.MethodPost, "/api/bookings/:id/no-show", openapi.Operation{
+		Summary:  "Mark booking as no-show",
+		Tag:      "booking",
+		Response: responses.DetailResponseType[BookingResponseDTO]{},
+	})
+	spec.Describe(http.MethodGet, "/api/users/:id/bookings", openapi.Operation{
+		Summary:  "List user bookings",
+		Tag:      "booking",
+		Query:    openapi.PaginationQuery{},
+		Response: responses.ListResponseType[BookingResponseDTO]{},
+	})
 }
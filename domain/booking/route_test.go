@@ -0,0 +1,197 @@
+package booking_test
+
+import (
+	"clean-architecture/domain/booking"
+	"clean-architecture/domain/models"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/openapi"
+	"clean-architecture/pkg/responses"
+	"clean-architecture/testutil"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/steinfletcher/apitest"
+	"go.uber.org/fx"
+)
+
+var _ = Describe("Domain/Booking/Route", Ordered, func() {
+	var (
+		router         infrastructure.Router
+		bookingService *booking.Service
+	)
+
+	BeforeAll(func() {
+		setupDI := func() {
+			err := testutil.DI(t,
+				fx.Populate(&router),
+				fx.Populate(&bookingService),
+			)
+			if err != nil {
+				t.Error(err)
+			}
+		}
+		setupDI()
+	})
+
+	It("should require admin to get booking stats", func() {
+		apitest.
+			New().
+			Handler(router).
+			Get("/api/bookings/stats").
+			Expect(t).
+			Status(http.StatusForbidden).
+			End()
+	})
+
+	It("should require admin to get booking analytics", func() {
+		apitest.
+			New().
+			Handler(router).
+			Get("/api/bookings/analytics").
+			Query("from", "2025-01-01T00:00:00Z").
+			Query("to", "2025-02-01T00:00:00Z").
+			Expect(t).
+			Status(http.StatusForbidden).
+			End()
+	})
+
+	It("should require admin to list resource conflicts", func() {
+		apitest.
+			New().
+			Handler(router).
+			Get("/api/resources/"+uuid.NewString()+"/conflicts").
+			Query("from", "2025-01-01T00:00:00Z").
+			Query("to", "2025-02-01T00:00:00Z").
+			Expect(t).
+			Status(http.StatusForbidden).
+			End()
+	})
+
+	It("should require admin to mark a booking as no-show", func() {
+		apitest.
+			New().
+			Handler(router).
+			Post("/api/bookings/" + uuid.NewString() + "/no-show").
+			Expect(t).
+			Status(http.StatusForbidden).
+			End()
+	})
+
+	DescribeTable("should return bad request for malformed ids",
+		func(method, target string) {
+			// apitest drops a query string left in the url
+			path, rawQuery, _ := strings.Cut(target, "?")
+			query, err := url.ParseQuery(rawQuery)
+			Expect(err).NotTo(HaveOccurred())
+
+			result := apitest.
+				New().
+				Handler(router).
+				Method(method).
+				URL(path).
+				QueryCollection(query).
+				Expect(t).
+				Status(http.StatusBadRequest).
+				End()
+
+			var responseBody struct {
+				Error string `json:"error"`
+			}
+			Expect(json.NewDecoder(result.Response.Body).Decode(&responseBody)).To(Succeed())
+			Expect(responseBody.Error).To(ContainSubstring("not-a-uuid"))
+		},
+		Entry("get resource", http.MethodGet, "/api/resources/not-a-uuid"),
+		Entry("update resource", http.MethodPut, "/api/resources/not-a-uuid"),
+		Entry("delete resource", http.MethodDelete, "/api/resources/not-a-uuid"),
+		Entry("start resource maintenance", http.MethodPost, "/api/resources/not-a-uuid/maintenance"),
+		Entry("end resource maintenance", http.MethodDelete, "/api/resources/not-a-uuid/maintenance"),
+		Entry("check resource availability", http.MethodGet, "/api/resources/not-a-uuid/availability"),
+		Entry("create availability", http.MethodPost, "/api/resources/not-a-uuid/availability"),
+		Entry("list resource availabilities", http.MethodGet, "/api/resources/not-a-uuid/availabilities"),
+		Entry("get booking", http.MethodGet, "/api/bookings/not-a-uuid"),
+		Entry("update booking", http.MethodPut, "/api/bookings/not-a-uuid"),
+		Entry("cancel booking", http.MethodDelete, "/api/bookings/not-a-uuid"),
+		Entry("check in booking", http.MethodPost, "/api/bookings/not-a-uuid/check-in"),
+		Entry("check out booking", http.MethodPost, "/api/bookings/not-a-uuid/check-out"),
+		Entry("list user bookings", http.MethodGet, "/api/users/not-a-uuid/bookings"),
+		Entry("check multiple resources availability", http.MethodGet,
+			"/api/availability?resource_ids="+uuid.NewString()+"&resource_ids=not-a-uuid"+
+				"&start=2030-01-07T10:00:00Z&end=2030-01-07T11:00:00Z"),
+	)
+
+	It("should report next pages until the last full page", func() {
+		prefix := "Full Page " + uuid.NewString()[:8]
+		for i := 1; i <= 4; i++ {
+			resource := &models.Resource{Name: fmt.Sprintf("%s %d", prefix, i), Type: "room"}
+			Expect(bookingService.CreateResource(resource)).To(BeNil())
+		}
+
+		listPage := func(page int) responses.ListResponseType[booking.ResourceResponseDTO] {
+			result := apitest.
+				New().
+				Handler(router).
+				Get("/api/resources").
+				QueryParams(map[string]string{
+					"search": prefix,
+					"page":   strconv.Itoa(page),
+					"limit":  "2",
+				}).
+				Expect(t).
+				Status(http.StatusOK).
+				End()
+
+			var responseBody responses.ListResponseType[booking.ResourceResponseDTO]
+			Expect(json.NewDecoder(result.Response.Body).Decode(&responseBody)).To(Succeed())
+			return responseBody
+		}
+
+		firstPage := listPage(1)
+		Expect(firstPage.Pagination.Total).To(Equal(int64(4)))
+		Expect(firstPage.Pagination.HasNext).To(BeTrue())
+
+		lastPage := listPage(2)
+		Expect(lastPage.Items).To(HaveLen(2))
+		Expect(lastPage.Pagination.HasNext).To(BeFalse())
+		Expect(lastPage.Pagination.CurrentPage).To(Equal(2))
+		Expect(lastPage.Pagination.PerPage).To(Equal(2))
+		Expect(lastPage.Pagination.LastPage).To(Equal(2))
+	})
+
+	It("should return bad request for a negative page", func() {
+		apitest.
+			New().
+			Handler(router).
+			Get("/api/resources").
+			Query("page", "-1").
+			Expect(t).
+			Status(http.StatusBadRequest).
+			End()
+	})
+
+	It("should list the core paths in the openapi spec", func() {
+		result := apitest.
+			New().
+			Handler(router).
+			Get("/openapi.json").
+			Expect(t).
+			Status(http.StatusOK).
+			End()
+
+		var doc openapi.Document
+		Expect(json.NewDecoder(result.Response.Body).Decode(&doc)).To(Succeed())
+		Expect(doc.OpenAPI).To(Equal(openapi.Version))
+		Expect(doc.Paths).To(HaveKey("/api/bookings/{id}"))
+		Expect(doc.Paths).To(HaveKey("/api/resources"))
+		Expect(doc.Paths).To(HaveKey("/api/todos"))
+		Expect(doc.Paths).To(HaveKey("/api/organizations"))
+		Expect(doc.Paths["/api/bookings"]).To(HaveKey("post"))
+		Expect(doc.Paths["/api/bookings"]["post"].RequestBody).NotTo(BeNil())
+	})
+})
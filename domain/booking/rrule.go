@@ -0,0 +1,75 @@
+package booking
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// supportedFrequencies are the RRULE frequencies recurring availabilities can use
+var supportedFrequencies = map[rrule.Frequency]bool{
+	rrule.DAILY:   true,
+	rrule.WEEKLY:  true,
+	rrule.MONTHLY: true,
+	rrule.YEARLY:  true,
+}
+
+// parseRRULE parses a recurrence rule like FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20301231T000000Z
+// on top of RFC 5545 it only allows day based frequencies and keeps COUNT and UNTIL exclusive
+func parseRRULE(rule string) (rrule.ROption, error) {
+	rule = strings.ToUpper(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"))
+	if rule == "" {
+		return rrule.ROption{}, fmt.Errorf("%w: rule is required for recurring availability", ErrInvalidRecurRule)
+	}
+
+	option, err := rrule.StrToROptionInLocation(rule, time.UTC)
+	if err != nil {
+		return rrule.ROption{}, fmt.Errorf("%w: %s", ErrInvalidRecurRule, err)
+	}
+
+	if !supportedFrequencies[option.Freq] {
+		return rrule.ROption{}, fmt.Errorf("%w: unsupported FREQ %s", ErrInvalidRecurRule, option.Freq)
+	}
+	// A missing INTERVAL or COUNT parses as zero, so only the given ones are checked
+	for _, part := range strings.Split(rule, ";") {
+		name, _, _ := strings.Cut(part, "=")
+		if (name == "INTERVAL" && option.Interval <= 0) || (name == "COUNT" && option.Count <= 0) {
+			return rrule.ROption{}, fmt.Errorf("%w: INTERVAL and COUNT must be positive numbers", ErrInvalidRecurRule)
+		}
+	}
+	if option.Count > 0 && !option.Until.IsZero() {
+		return rrule.ROption{}, fmt.Errorf("%w: COUNT and UNTIL cannot be used together", ErrInvalidRecurRule)
+	}
+	for _, day := range option.Byweekday {
+		// ordinal days like 1MO only make sense within a month or year
+		if day.N() != 0 && option.Freq != rrule.MONTHLY && option.Freq != rrule.YEARLY {
+			return rrule.ROption{}, fmt.Errorf("%w: BYDAY %s requires a MONTHLY or YEARLY rule", ErrInvalidRecurRule, day)
+		}
+	}
+
+	// Check the BY* ranges such as BYMONTHDAY=32
+	if _, err := rrule.NewRRule(*option); err != nil {
+		return rrule.ROption{}, fmt.Errorf("%w: %s", ErrInvalidRecurRule, err)
+	}
+
+	return *option, nil
+}
+
+// validateRRULE validates a recurrence rule of a recurring availability
+func validateRRULE(rule string) error {
+	_, err := parseRRULE(rule)
+	return err
+}
+
+// expandRecurrence returns the occurrences of the recurrence starting at dtstart, between from and until inclusive
+// occurrences keep the time of day and location of dtstart
+func expandRecurrence(option rrule.ROption, dtstart, from, until time.Time) ([]time.Time, error) {
+	option.Dtstart = dtstart
+	recurrence, err := rrule.NewRRule(option)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRecurRule, err)
+	}
+	return recurrence.Between(from, until, true), nil
+}
@@ -0,0 +1,88 @@
+package booking
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Domain/Booking/validateRRULE", func() {
+	DescribeTable("should accept valid rules",
+		func(rule string) {
+			Expect(validateRRULE(rule)).To(Succeed())
+		},
+		Entry("weekly on weekdays", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"),
+		Entry("weekly with interval and until", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO;UNTIL=20301231T000000Z"),
+		Entry("weekly with count", "FREQ=WEEKLY;BYDAY=SA,SU;COUNT=10"),
+		Entry("prefixed rule", "RRULE:FREQ=WEEKLY;BYDAY=FR"),
+		Entry("monthly on the first monday", "FREQ=MONTHLY;BYDAY=1MO"),
+		Entry("daily until a date", "FREQ=DAILY;UNTIL=20301231"),
+		Entry("monthly on the last day", "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=6"),
+	)
+
+	DescribeTable("should reject malformed rules",
+		func(rule string) {
+			err := validateRRULE(rule)
+			Expect(err).To(MatchError(ErrInvalidRecurRule))
+		},
+		Entry("empty rule", ""),
+		Entry("missing FREQ", "BYDAY=MO,WE"),
+		Entry("unsupported FREQ", "FREQ=SECONDLY"),
+		Entry("invalid BYDAY", "FREQ=WEEKLY;BYDAY=MO,XX"),
+		Entry("ordinal BYDAY on a weekly rule", "FREQ=WEEKLY;BYDAY=1MO"),
+		Entry("invalid UNTIL", "FREQ=WEEKLY;UNTIL=2030-12-31"),
+		Entry("COUNT together with UNTIL", "FREQ=DAILY;COUNT=5;UNTIL=20301231"),
+		Entry("non numeric INTERVAL", "FREQ=DAILY;INTERVAL=two"),
+		Entry("malformed part", "FREQ=WEEKLY;BYDAY"),
+		Entry("unknown part", "FREQ=WEEKLY;FOO=BAR"),
+		Entry("out of range BYMONTHDAY", "FREQ=MONTHLY;BYMONTHDAY=32"),
+		Entry("negative COUNT", "FREQ=DAILY;COUNT=-1"),
+		Entry("zero COUNT", "FREQ=DAILY;COUNT=0"),
+		Entry("zero INTERVAL", "FREQ=WEEKLY;INTERVAL=0;BYDAY=MO"),
+	)
+
+	// examples from RFC 5545 section 3.8.5.3, starting 1997-09-02 09:00 New York time unless noted
+	DescribeTable("should expand rules like the RFC 5545 examples",
+		func(rule string, dtstart time.Time, expected ...time.Time) {
+			option, err := parseRRULE(rule)
+			Expect(err).NotTo(HaveOccurred())
+
+			occurrences, err := expandRecurrence(option, dtstart, dtstart, dtstart.AddDate(1, 0, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(occurrences).To(HaveLen(len(expected)))
+			for i := range expected {
+				Expect(occurrences[i]).To(BeTemporally("==", expected[i]))
+			}
+		},
+		Entry("daily for 3 occurrences", "FREQ=DAILY;COUNT=3",
+			rfcDate(1997, 9, 2), rfcDate(1997, 9, 2), rfcDate(1997, 9, 3), rfcDate(1997, 9, 4)),
+		Entry("every other week on tuesday and thursday for 4 occurrences", "FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,TH",
+			rfcDate(1997, 9, 2), rfcDate(1997, 9, 2), rfcDate(1997, 9, 4), rfcDate(1997, 9, 16), rfcDate(1997, 9, 18)),
+		Entry("monthly on the first friday for 3 occurrences", "FREQ=MONTHLY;COUNT=3;BYDAY=1FR",
+			rfcDate(1997, 9, 5), rfcDate(1997, 9, 5), rfcDate(1997, 10, 3), rfcDate(1997, 11, 7)),
+		Entry("monthly on the second to last day for 3 occurrences", "FREQ=MONTHLY;BYMONTHDAY=-2;COUNT=3",
+			rfcDate(1997, 9, 28), rfcDate(1997, 9, 29), rfcDate(1997, 10, 30), rfcDate(1997, 11, 29)),
+		Entry("weekly on tuesday until a date", "FREQ=WEEKLY;BYDAY=TU;UNTIL=19970917T000000Z",
+			rfcDate(1997, 9, 2), rfcDate(1997, 9, 2), rfcDate(1997, 9, 9), rfcDate(1997, 9, 16)),
+	)
+
+	It("should only return occurrences within the requested range", func() {
+		option, err := parseRRULE("FREQ=DAILY")
+		Expect(err).NotTo(HaveOccurred())
+
+		occurrences, err := expandRecurrence(option, rfcDate(1997, 9, 2), rfcDate(1997, 9, 10), rfcDate(1997, 9, 12))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(occurrences).To(HaveLen(3))
+		Expect(occurrences[0]).To(BeTemporally("==", rfcDate(1997, 9, 10)))
+	})
+})
+
+// rfcDate returns 09:00 New York time on the day, the start time of the RFC 5545 examples
+func rfcDate(year int, month time.Month, day int) time.Time {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		location = time.FixedZone("EDT", -4*60*60)
+	}
+	return time.Date(year, month, day, 9, 0, 0, 0, location)
+}
@@ -0,0 +1,107 @@
+package booking
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// maxScheduleHorizon caps how far ahead availabilities can be generated at once
+const maxScheduleHorizon = 366 * 24 * time.Hour
+
+// weekdayCodes maps the RRULE day codes to weekdays
+var weekdayCodes = map[string]rrule.Weekday{
+	"MO": rrule.MO,
+	"TU": rrule.TU,
+	"WE": rrule.WE,
+	"TH": rrule.TH,
+	"FR": rrule.FR,
+	"SA": rrule.SA,
+	"SU": rrule.SU,
+}
+
+// weeklySchedule describes windows repeating on the same days every week, e.g. Mon-Fri 9-17
+type weeklySchedule struct {
+	days  []rrule.Weekday
+	start time.Duration
+	end   time.Duration
+}
+
+// timeWindow is a single generated start and end
+type timeWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// newWeeklySchedule parses RRULE day codes and HH:MM times of day into a schedule
+func newWeeklySchedule(days []string, startTime, endTime string) (weeklySchedule, error) {
+	schedule := weeklySchedule{}
+	if len(days) == 0 {
+		return schedule, fmt.Errorf("%w: at least one day of the week is required", ErrInvalidAvailabilitySchedule)
+	}
+	for _, day := range days {
+		weekday, ok := weekdayCodes[strings.ToUpper(strings.TrimSpace(day))]
+		if !ok {
+			return schedule, fmt.Errorf("%w: unknown day of the week %q", ErrInvalidAvailabilitySchedule, day)
+		}
+		if !slices.Contains(schedule.days, weekday) {
+			schedule.days = append(schedule.days, weekday)
+		}
+	}
+
+	var err error
+	if schedule.start, err = parseTimeOfDay(startTime); err != nil {
+		return schedule, err
+	}
+	if schedule.end, err = parseTimeOfDay(endTime); err != nil {
+		return schedule, err
+	}
+	if schedule.end <= schedule.start {
+		return schedule, fmt.Errorf("%w: end time must be after start time", ErrInvalidAvailabilitySchedule)
+	}
+	return schedule, nil
+}
+
+// parseTimeOfDay parses HH:MM into the offset from midnight
+func parseTimeOfDay(value string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("%w: time of day %q must be HH:MM", ErrInvalidAvailabilitySchedule, value)
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+// windows returns the windows of the schedule starting from the from date up to and including the until date
+// times of day are taken in the location of from
+func (s weeklySchedule) windows(from, until time.Time) ([]timeWindow, error) {
+	first := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	last := time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, from.Location())
+
+	dtstart := atTimeOfDay(first, s.start)
+	occurrences, err := expandRecurrence(
+		rrule.ROption{Freq: rrule.WEEKLY, Byweekday: s.days},
+		dtstart, dtstart, atTimeOfDay(last, s.start),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make([]timeWindow, len(occurrences))
+	for i, occurrence := range occurrences {
+		windows[i] = timeWindow{
+			start: occurrence,
+			end:   atTimeOfDay(occurrence, s.end),
+		}
+	}
+	return windows, nil
+}
+
+// atTimeOfDay returns the wall clock time of the day, unaffected by daylight saving changes
+func atTimeOfDay(day time.Time, offset time.Duration) time.Time {
+	hours := int(offset / time.Hour)
+	minutes := int(offset % time.Hour / time.Minute)
+	return time.Date(day.Year(), day.Month(), day.Day(), hours, minutes, 0, 0, day.Location())
+}
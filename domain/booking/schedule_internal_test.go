@@ -0,0 +1,56 @@
+package booking
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Domain/Booking/weeklySchedule", func() {
+	It("should generate a window for every matching day including the until date", func() {
+		schedule, err := newWeeklySchedule([]string{"MO", "TU", "WE", "TH", "FR"}, "09:00", "17:30")
+		Expect(err).NotTo(HaveOccurred())
+
+		// 2030-01-07 is a Monday, so two full weeks end on Sunday 2030-01-20
+		from := time.Date(2030, 1, 7, 0, 0, 0, 0, time.UTC)
+		until := time.Date(2030, 1, 20, 0, 0, 0, 0, time.UTC)
+
+		windows, err := schedule.windows(from, until)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(windows).To(HaveLen(10))
+		Expect(windows[0].start).To(Equal(time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)))
+		Expect(windows[0].end).To(Equal(time.Date(2030, 1, 7, 17, 30, 0, 0, time.UTC)))
+		Expect(windows[9].start.Weekday()).To(Equal(time.Friday))
+	})
+
+	It("should keep the wall clock time across daylight saving changes", func() {
+		location, err := time.LoadLocation("Europe/Berlin")
+		if err != nil {
+			Skip("timezone data is not available")
+		}
+		schedule, err := newWeeklySchedule([]string{"su"}, "09:00", "10:00")
+		Expect(err).NotTo(HaveOccurred())
+
+		// clocks go forward on 2030-03-31
+		windows, err := schedule.windows(
+			time.Date(2030, 3, 24, 0, 0, 0, 0, location),
+			time.Date(2030, 3, 31, 0, 0, 0, 0, location),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(windows).To(HaveLen(2))
+		Expect(windows[1].start.Hour()).To(Equal(9))
+	})
+
+	DescribeTable("should reject malformed schedules",
+		func(days []string, start, end string) {
+			_, err := newWeeklySchedule(days, start, end)
+			Expect(err).To(MatchError(ErrInvalidAvailabilitySchedule))
+		},
+		Entry("no days", []string{}, "09:00", "17:00"),
+		Entry("unknown day", []string{"MO", "XX"}, "09:00", "17:00"),
+		Entry("invalid start time", []string{"MO"}, "9am", "17:00"),
+		Entry("out of range end time", []string{"MO"}, "09:00", "25:00"),
+		Entry("end before start", []string{"MO"}, "17:00", "09:00"),
+	)
+})
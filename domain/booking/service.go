@@ -1,9 +1,12 @@
 package booking
 
 import (
+	"context"
 	"errors"
+	"slices"
 	"time"
 
+	"clean-architecture/domain/constants"
 	"clean-architecture/domain/models"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/types"
@@ -26,11 +29,25 @@ func NewService(logger framework.Logger, repository Repository) *Service {
 	}
 }
 
+// WithContext returns a copy of the service running its queries with the context,
+// so they are cancelled together with the request
+func (s *Service) WithContext(ctx context.Context) *Service {
+	scoped := *s
+	scoped.repository = s.repository.WithContext(ctx)
+	return &scoped
+}
+
 // -------------- Resource Service Methods --------------
 
 // CreateResource creates a new resource
 func (s *Service) CreateResource(resource *models.Resource) error {
 	s.logger.Info("[BookingService...CreateResource]")
+
+	// Validate attributes against the resource type schema
+	if err := validateAttributes(resource.Type, resource.Attributes); err != nil {
+		return err
+	}
+
 	return s.repository.CreateResource(resource)
 }
 
@@ -67,6 +84,11 @@ func (s *Service) UpdateResource(id types.BinaryUUID, updateFn func(*models.Reso
 		return err
 	}
 
+	// Validate attributes against the resource type schema
+	if err := validateAttributes(resource.Type, resource.Attributes); err != nil {
+		return err
+	}
+
 	// Save updated resource
 	return s.repository.UpdateResource(&resource)
 }
@@ -88,10 +110,26 @@ func (s *Service) DeleteResource(id types.BinaryUUID) error {
 	return s.repository.DeleteResource(id)
 }
 
+// SetResourceMaintenance puts a resource under maintenance or clears it
+func (s *Service) SetResourceMaintenance(id types.BinaryUUID, underMaintenance bool) error {
+	s.logger.Info("[BookingService...SetResourceMaintenance]")
+
+	return s.UpdateResource(id, func(resource *models.Resource) error {
+		resource.UnderMaintenance = underMaintenance
+		return nil
+	})
+}
+
+// GetResourceNames gets resource names by UUID, unknown resources are left out
+func (s *Service) GetResourceNames(ids ...types.BinaryUUID) (map[types.BinaryUUID]string, error) {
+	s.logger.Info("[BookingService...GetResourceNames]")
+	return s.repository.GetResourceNames(ids)
+}
+
 // ListResources lists resources with pagination and filtering
-func (s *Service) ListResources(page, limit int, filters map[string]interface{}) ([]models.Resource, int64, error) {
+func (s *Service) ListResources(page, limit int, search string, filters map[string]interface{}) ([]models.Resource, int64, error) {
 	s.logger.Info("[BookingService...ListResources]")
-	return s.repository.ListResources(page, limit, filters)
+	return s.repository.ListResources(page, limit, search, filters)
 }
 
 // -------------- Availability Service Methods --------------
@@ -105,6 +143,13 @@ func (s *Service) CreateAvailability(resourceID types.BinaryUUID, availability *
 		return ErrInvalidTimeRange
 	}
 
+	// Validate recurrence rule
+	if availability.IsRecurring {
+		if err := validateRRULE(availability.RecurRule); err != nil {
+			return err
+		}
+	}
+
 	// Check if resource exists
 	_, err := s.repository.GetResourceByID(resourceID)
 	if err != nil {
@@ -114,6 +159,22 @@ func (s *Service) CreateAvailability(resourceID types.BinaryUUID, availability *
 		return err
 	}
 
+	// Reject windows overlapping the existing ones, comparing the occurrences of recurring windows
+	// up to the schedule horizon
+	until := availability.EndTime
+	if availability.IsRecurring {
+		until = availability.StartTime.Add(maxScheduleHorizon)
+	}
+	overlapping, err := s.repository.FindOverlappingAvailabilities(resourceID, availability.StartTime, until)
+	if err != nil {
+		return err
+	}
+	for _, existing := range overlapping {
+		if availabilitiesOverlap(*availability, existing, until) {
+			return ErrAvailabilityOverlap
+		}
+	}
+
 	// Set resource ID
 	availability.ResourceID = resourceID
 
@@ -129,6 +190,68 @@ func (s *Service) CreateAvailability(resourceID types.BinaryUUID, availability *
 	return s.repository.CreateAvailability(availability)
 }
 
+// GenerateAvailabilities creates an availability on the given days of every week between startTime and endTime (HH:MM)
+// from the from date until the until date. Windows already in the past are skipped, and none are created when any
+// window overlaps an existing availability
+func (s *Service) GenerateAvailabilities(
+	resourceID types.BinaryUUID,
+	days []string,
+	startTime, endTime string,
+	from, until time.Time,
+) ([]models.Availability, error) {
+	s.logger.Info("[BookingService...GenerateAvailabilities]")
+
+	schedule, err := newWeeklySchedule(days, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if until.Before(from) || until.Sub(from) > maxScheduleHorizon {
+		return nil, ErrInvalidTimeRange
+	}
+
+	// Check if resource exists
+	_, err = s.repository.GetResourceByID(resourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+
+	var availabilities []models.Availability
+	now := time.Now()
+	windows, err := schedule.windows(from, until)
+	if err != nil {
+		return nil, err
+	}
+	for _, window := range windows {
+		if window.start.Before(now) {
+			continue
+		}
+
+		overlapping, err := s.repository.FindOverlappingAvailabilities(resourceID, window.start, window.end)
+		if err != nil {
+			return nil, err
+		}
+		if len(overlapping) > 0 {
+			return nil, ErrAvailabilityOverlap
+		}
+
+		availabilities = append(availabilities, models.Availability{
+			UUID:       types.BinaryUUID(uuid.New()),
+			ResourceID: resourceID,
+			StartTime:  window.start,
+			EndTime:    window.end,
+		})
+	}
+
+	if len(availabilities) == 0 {
+		return availabilities, nil
+	}
+	return availabilities, s.repository.CreateAvailabilities(availabilities)
+}
+
 // GetAvailabilityByID gets an availability by ID
 func (s *Service) GetAvailabilityByID(id types.BinaryUUID) (models.Availability, error) {
 	s.logger.Info("[BookingService...GetAvailabilityByID]")
@@ -167,6 +290,13 @@ func (s *Service) UpdateAvailability(id types.BinaryUUID, updateFn func(*models.
 		return ErrInvalidTimeRange
 	}
 
+	// Validate recurrence rule
+	if availability.IsRecurring {
+		if err := validateRRULE(availability.RecurRule); err != nil {
+			return err
+		}
+	}
+
 	// Save updated availability
 	return s.repository.UpdateAvailability(&availability)
 }
@@ -188,25 +318,31 @@ func (s *Service) DeleteAvailability(id types.BinaryUUID) error {
 	return s.repository.DeleteAvailability(id)
 }
 
-// ListAvailabilitiesByResourceID lists availabilities for a resource
-func (s *Service) ListAvailabilitiesByResourceID(resourceID types.BinaryUUID) ([]models.Availability, error) {
+// ListAvailabilitiesByResourceID lists availabilities for a resource with pagination
+func (s *Service) ListAvailabilitiesByResourceID(resourceID types.BinaryUUID, page, limit int) ([]models.Availability, int64, error) {
 	s.logger.Info("[BookingService...ListAvailabilitiesByResourceID]")
 
 	// Check if resource exists
 	_, err := s.repository.GetResourceByID(resourceID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, ErrResourceNotFound
+			return nil, 0, ErrResourceNotFound
 		}
-		return nil, err
+		return nil, 0, err
 	}
 
-	return s.repository.ListAvailabilitiesByResourceID(resourceID)
+	return s.repository.ListAvailabilitiesByResourceID(resourceID, page, limit)
 }
 
 // CheckResourceAvailability checks if a resource is available for a specific time period
 func (s *Service) CheckResourceAvailability(resourceID types.BinaryUUID, start, end time.Time) (bool, error) {
 	s.logger.Info("[BookingService...CheckResourceAvailability]")
+	return s.checkSeatsAvailability(resourceID, start, end, 1)
+}
+
+// checkSeatsAvailability checks if a resource has the seats free for a specific time period
+// overlapping bookings share the capacity of seat based resources, other resources keep bookings exclusive
+func (s *Service) checkSeatsAvailability(resourceID types.BinaryUUID, start, end time.Time, seats int) (bool, error) {
 
 	// Validate input parameters
 	if end.Before(start) || start.Before(time.Now()) {
@@ -214,7 +350,7 @@ func (s *Service) CheckResourceAvailability(resourceID types.BinaryUUID, start,
 	}
 
 	// Check if resource exists
-	_, err := s.repository.GetResourceByID(resourceID)
+	resource, err := s.repository.GetResourceByID(resourceID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return false, ErrResourceNotFound
@@ -222,13 +358,18 @@ func (s *Service) CheckResourceAvailability(resourceID types.BinaryUUID, start,
 		return false, err
 	}
 
-	// Check for overlapping bookings
-	overlapping, err := s.repository.FindOverlappingBookings(resourceID, start, end)
+	// Resources under maintenance can't be booked
+	if resource.UnderMaintenance {
+		return false, nil
+	}
+
+	// Check the seats taken by overlapping bookings, keeping the resource's buffer free around them
+	overlapping, err := s.repository.FindOverlappingBookings(resourceID, start.Add(-resource.Buffer()), end.Add(resource.Buffer()))
 	if err != nil {
 		return false, err
 	}
 
-	if len(overlapping) > 0 {
+	if bookedSeats(overlapping, types.BinaryUUID{})+seats > resource.SeatCapacity() {
 		return false, nil
 	}
 
@@ -257,8 +398,29 @@ func (s *Service) CreateBooking(booking *models.Booking) error {
 		return ErrPastDateBooking
 	}
 
+	// Check resource is not under maintenance
+	resource, err := s.repository.GetResourceByID(booking.ResourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrResourceNotFound
+		}
+		return err
+	}
+
+	if resource.UnderMaintenance {
+		return ErrResourceUnderMaintenance
+	}
+
+	// Bookings take one seat unless more are requested
+	if booking.Seats < 1 {
+		booking.Seats = 1
+	}
+	if booking.Seats > resource.SeatCapacity() {
+		return ErrExceedsCapacity
+	}
+
 	// Check availability first
-	available, err := s.CheckResourceAvailability(booking.ResourceID, booking.StartTime, booking.EndTime)
+	available, err := s.checkSeatsAvailability(booking.ResourceID, booking.StartTime, booking.EndTime, booking.Seats)
 	if err != nil {
 		return err
 	}
@@ -267,8 +429,20 @@ func (s *Service) CreateBooking(booking *models.Booking) error {
 		return ErrResourceNotAvailable
 	}
 
+	// Provided references must be unique, missing ones are generated on create
+	if booking.Reference != "" {
+		exists, err := s.repository.BookingReferenceExists(booking.Reference)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrDuplicateBookingReference
+		}
+	}
+
 	// Generate UUID if not provided
-	if booking.UUID.String() == (types.BinaryUUID{}).String() {
+	generatedID := booking.UUID.String() == (types.BinaryUUID{}).String()
+	if generatedID {
 		id, err := uuid.NewRandom()
 		if err != nil {
 			return err
@@ -278,13 +452,33 @@ func (s *Service) CreateBooking(booking *models.Booking) error {
 
 	// Set initial status if not provided
 	if booking.Status == "" {
-		booking.Status = "confirmed"
+		booking.Status = constants.BookingStatusConfirmed
 	}
 
-	// Save to database
-	return s.repository.CreateBooking(booking)
+	// Save to database, the reference is generated from the UUID when missing
+	generatedReference := booking.Reference == ""
+	for attempt := 1; ; attempt++ {
+		err := s.repository.CreateBooking(booking)
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			return err
+		}
+
+		// Retry a generated reference that collided with a fresh UUID
+		if !generatedID || !generatedReference || attempt == maxReferenceAttempts {
+			return ErrDuplicateBookingReference
+		}
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return err
+		}
+		booking.UUID = types.BinaryUUID(id)
+		booking.Reference = ""
+	}
 }
 
+// maxReferenceAttempts is how many generated booking references are tried before giving up
+const maxReferenceAttempts = 3
+
 // GetBookingByID gets a booking by ID
 func (s *Service) GetBookingByID(id types.BinaryUUID) (models.Booking, error) {
 	s.logger.Info("[BookingService...GetBookingByID]")
@@ -316,6 +510,8 @@ func (s *Service) UpdateBooking(id types.BinaryUUID, updateFn func(*models.Booki
 	// Store original times to check availability if they change
 	originalStart := booking.StartTime
 	originalEnd := booking.EndTime
+	originalReference := booking.Reference
+	originalStatus := booking.Status
 
 	// Apply updates via callback function
 	if err := updateFn(&booking); err != nil {
@@ -332,6 +528,23 @@ func (s *Service) UpdateBooking(id types.BinaryUUID, updateFn func(*models.Booki
 		return ErrInvalidBookingStatus
 	}
 
+	// Updates can only cancel a booking still holding its slot,
+	// restoring and marking no-shows go through their own checks
+	if booking.Status != originalStatus && !canUpdateStatus(originalStatus, booking.Status) {
+		return ErrInvalidBookingStatus
+	}
+
+	// Changed references must stay unique
+	if booking.Reference != originalReference {
+		exists, err := s.repository.BookingReferenceExists(booking.Reference)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrDuplicateBookingReference
+		}
+	}
+
 	// If times changed, check availability
 	if !booking.StartTime.Equal(originalStart) || !booking.EndTime.Equal(originalEnd) {
 		// Check if booking is in the past
@@ -339,23 +552,27 @@ func (s *Service) UpdateBooking(id types.BinaryUUID, updateFn func(*models.Booki
 			return ErrPastDateBooking
 		}
 
-		// For the availability check, we need to exclude the current booking
-		// Get other overlapping bookings
-		overlapping, err := s.repository.FindOverlappingBookings(booking.ResourceID, booking.StartTime, booking.EndTime)
+		resource, err := s.repository.GetResourceByID(booking.ResourceID)
 		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrResourceNotFound
+			}
 			return err
 		}
 
-		// Filter out the current booking
-		hasOverlap := false
-		for _, b := range overlapping {
-			if b.UUID != booking.UUID {
-				hasOverlap = true
-				break
-			}
+		// For the availability check, we need to exclude the current booking
+		// Get other overlapping bookings, keeping the resource's buffer free around them
+		overlapping, err := s.repository.FindOverlappingBookings(
+			booking.ResourceID,
+			booking.StartTime.Add(-resource.Buffer()),
+			booking.EndTime.Add(resource.Buffer()),
+		)
+		if err != nil {
+			return err
 		}
 
-		if hasOverlap {
+		// Seats of the current booking are not counted against itself
+		if bookedSeats(overlapping, booking.UUID)+max(booking.Seats, 1) > resource.SeatCapacity() {
 			return ErrBookingOverlap
 		}
 
@@ -388,33 +605,216 @@ func (s *Service) CancelBooking(id types.BinaryUUID) error {
 	}
 
 	// Set status to cancelled
-	booking.Status = "cancelled"
+	booking.Status = constants.BookingStatusCancelled
 
 	// Save updated booking
 	return s.repository.UpdateBooking(&booking)
 }
 
+// RestoreBooking confirms a cancelled booking again when its slot is still free
+func (s *Service) RestoreBooking(id types.BinaryUUID) error {
+	s.logger.Info("[BookingService...RestoreBooking]")
+
+	// Get existing booking
+	booking, err := s.repository.GetBookingByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBookingNotFound
+		}
+		return err
+	}
+
+	if booking.Status != constants.BookingStatusCancelled {
+		return ErrInvalidBookingStatus
+	}
+
+	// The cancelled booking doesn't hold its seats, so a newer booking may have taken the slot
+	available, err := s.checkSeatsAvailability(booking.ResourceID, booking.StartTime, booking.EndTime, booking.Seats)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return ErrResourceNotAvailable
+	}
+
+	booking.Status = constants.BookingStatusConfirmed
+
+	return s.repository.UpdateBooking(&booking)
+}
+
+// MarkBookingNoShow flags a booked but unused reservation as no-show, freeing the slot
+func (s *Service) MarkBookingNoShow(id types.BinaryUUID) error {
+	s.logger.Info("[BookingService...MarkBookingNoShow]")
+
+	// Get existing booking
+	booking, err := s.repository.GetBookingByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBookingNotFound
+		}
+		return err
+	}
+
+	// Only bookings still holding the slot and never used can become no-shows
+	if !slices.Contains(constants.BookingOccupyingStatuses, booking.Status) || booking.CheckedInAt != nil {
+		return ErrInvalidBookingStatus
+	}
+
+	booking.Status = constants.BookingStatusNoShow
+
+	return s.repository.UpdateBooking(&booking)
+}
+
+// CheckInBooking stamps the check-in time of a booking
+func (s *Service) CheckInBooking(id types.BinaryUUID) error {
+	s.logger.Info("[BookingService...CheckInBooking]")
+
+	// Get existing booking
+	booking, err := s.repository.GetBookingByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBookingNotFound
+		}
+		return err
+	}
+
+	// Cancelled and no-show bookings no longer hold the slot
+	if booking.Status == constants.BookingStatusCancelled || booking.Status == constants.BookingStatusNoShow {
+		return ErrInvalidBookingStatus
+	}
+
+	if booking.CheckedInAt != nil {
+		return ErrAlreadyCheckedIn
+	}
+
+	now := time.Now()
+	booking.CheckedInAt = &now
+
+	return s.repository.UpdateBooking(&booking)
+}
+
+// CheckOutBooking stamps the check-out time of a checked in booking
+func (s *Service) CheckOutBooking(id types.BinaryUUID) error {
+	s.logger.Info("[BookingService...CheckOutBooking]")
+
+	// Get existing booking
+	booking, err := s.repository.GetBookingByID(id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBookingNotFound
+		}
+		return err
+	}
+
+	if booking.CheckedInAt == nil {
+		return ErrNotCheckedIn
+	}
+
+	if booking.CheckedOutAt != nil {
+		return ErrAlreadyCheckedOut
+	}
+
+	now := time.Now()
+	booking.CheckedOutAt = &now
+
+	return s.repository.UpdateBooking(&booking)
+}
+
 // ListBookings lists bookings with pagination and filtering
 func (s *Service) ListBookings(page, limit int, filters map[string]interface{}) ([]models.Booking, int64, error) {
 	s.logger.Info("[BookingService...ListBookings]")
 	return s.repository.ListBookings(page, limit, filters)
 }
 
+// GetBookingStats counts bookings by status, every status is present even without bookings
+func (s *Service) GetBookingStats(start, end time.Time) (map[string]int64, error) {
+	s.logger.Info("[BookingService...GetBookingStats]")
+
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		return nil, ErrInvalidTimeRange
+	}
+
+	counts, err := s.repository.CountBookingsByStatus(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range constants.BookingStatuses {
+		if _, ok := counts[status]; !ok {
+			counts[status] = 0
+		}
+	}
+	return counts, nil
+}
+
+// maxAnalyticsRange caps the date range of the booking analytics, keeping the per-day list bounded
+const maxAnalyticsRange = 366 * 24 * time.Hour
+
+// GetBookingAnalytics returns the utilization of bookings starting in [from, to), optionally for one resource
+// every day of the range is listed, including the ones without bookings
+func (s *Service) GetBookingAnalytics(from, to time.Time, resourceID *types.BinaryUUID) (BookingAnalytics, error) {
+	s.logger.Info("[BookingService...GetBookingAnalytics]")
+
+	if !to.After(from) || to.Sub(from) > maxAnalyticsRange {
+		return BookingAnalytics{}, ErrInvalidTimeRange
+	}
+
+	bookings, err := s.repository.ListAnalyticsBookings(from, to, resourceID)
+	if err != nil {
+		return BookingAnalytics{}, err
+	}
+
+	return summarizeBookings(bookings, from, to), nil
+}
+
+// GetResourceConflicts returns the groups of pending or confirmed bookings of a resource overlapping each other within [from, to)
+func (s *Service) GetResourceConflicts(resourceID types.BinaryUUID, from, to time.Time) ([]conflictGroup, error) {
+	s.logger.Info("[BookingService...GetResourceConflicts]")
+
+	if !to.After(from) || to.Sub(from) > maxAnalyticsRange {
+		return nil, ErrInvalidTimeRange
+	}
+
+	// Check if resource exists
+	_, err := s.repository.GetResourceByID(resourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResourceNotFound
+		}
+		return nil, err
+	}
+
+	bookings, err := s.repository.ListResourceBookingsInRange(resourceID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupConflicts(bookings), nil
+}
+
 // ListBookingsByUserID lists bookings for a specific user
 func (s *Service) ListBookingsByUserID(userID types.BinaryUUID, page, limit int) ([]models.Booking, int64, error) {
 	s.logger.Info("[BookingService...ListBookingsByUserID]")
 	return s.repository.ListBookingsByUserID(userID, page, limit)
 }
 
-// Helper function to check if a booking status is valid
-func isValidStatus(status string) bool {
-	validStatuses := []string{"pending", "confirmed", "cancelled", "completed"}
-
-	for _, s := range validStatuses {
-		if status == s {
-			return true
+// bookedSeats sums the seats taken by bookings, skipping the excluded booking
+func bookedSeats(bookings []models.Booking, exclude types.BinaryUUID) int {
+	seats := 0
+	for _, b := range bookings {
+		if b.UUID != exclude {
+			seats += max(b.Seats, 1)
 		}
 	}
+	return seats
+}
+
+// Helper function to check if a booking status is valid
+func isValidStatus(status string) bool {
+	return slices.Contains(constants.BookingStatuses, status)
+}
 
-	return false
+// canUpdateStatus checks if a booking update may move the status from one to the other
+func canUpdateStatus(from, to string) bool {
+	return to == constants.BookingStatusCancelled && slices.Contains(constants.BookingOccupyingStatuses, from)
 }
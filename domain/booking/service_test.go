@@ -0,0 +1,627 @@
+package booking_test
+
+import (
+	"clean-architecture/domain/booking"
+	"clean-architecture/domain/constants"
+	"clean-architecture/domain/models"
+	"clean-architecture/pkg/types"
+	"clean-architecture/testutil"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx"
+)
+
+var _ = Describe("Domain/Booking/Service", Ordered, func() {
+	var (
+		bookingService *booking.Service
+		bookingRepo    booking.Repository
+	)
+
+	BeforeAll(func() {
+		setupDI := func() {
+			err := testutil.DI(t,
+				fx.Populate(&bookingService),
+				fx.Populate(&bookingRepo),
+			)
+			if err != nil {
+				t.Error(err)
+			}
+		}
+		setupDI()
+	})
+
+	// day is a future day with a 9 to 17 availability window for the test resources
+	day := time.Now().Add(72 * time.Hour).Truncate(24 * time.Hour)
+	at := func(hour int) time.Time {
+		return day.Add(time.Duration(hour) * time.Hour)
+	}
+
+	createResource := func(name string) *models.Resource {
+		resource := &models.Resource{
+			Name:     name,
+			Type:     "room",
+			Capacity: 1,
+		}
+		Expect(bookingService.CreateResource(resource)).To(BeNil())
+
+		availability := &models.Availability{
+			StartTime: at(9),
+			EndTime:   at(17),
+		}
+		Expect(bookingService.CreateAvailability(resource.UUID, availability)).To(BeNil())
+
+		return resource
+	}
+
+	createBookingWithStatus := func(resourceID types.BinaryUUID, from, to int, status string) *models.Booking {
+		b := &models.Booking{
+			ResourceID: resourceID,
+			UserID:     types.BinaryUUID(uuid.New()),
+			StartTime:  at(from),
+			EndTime:    at(to),
+			Status:     status,
+		}
+		Expect(bookingRepo.CreateBooking(b)).To(BeNil())
+		return b
+	}
+
+	DescribeTable("should respect the booking status when checking availability",
+		func(status string, expectedAvailable bool) {
+			resource := createResource("Status Room " + status)
+			createBookingWithStatus(resource.UUID, 10, 11, status)
+
+			available, err := bookingService.CheckResourceAvailability(resource.UUID, at(10), at(11))
+			Expect(err).To(BeNil())
+			Expect(available).To(Equal(expectedAvailable))
+		},
+		Entry("pending bookings occupy the slot", constants.BookingStatusPending, false),
+		Entry("confirmed bookings occupy the slot", constants.BookingStatusConfirmed, false),
+		Entry("cancelled bookings free the slot", constants.BookingStatusCancelled, true),
+		Entry("completed bookings free the slot", constants.BookingStatusCompleted, true),
+	)
+
+	It("should keep the resource buffer free around existing bookings", func() {
+		resource := createResource("Buffered Room")
+		resource.BufferMinutes = 30
+		Expect(bookingRepo.UpdateResource(resource)).To(BeNil())
+
+		createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+
+		withinBuffer := &models.Booking{
+			ResourceID: resource.UUID,
+			UserID:     types.BinaryUUID(uuid.New()),
+			StartTime:  at(11).Add(29 * time.Minute),
+			EndTime:    at(12),
+		}
+		Expect(bookingService.CreateBooking(withinBuffer)).To(MatchError(booking.ErrResourceNotAvailable))
+
+		// a booking may start as soon as the buffer is over
+		outsideBuffer := &models.Booking{
+			ResourceID: resource.UUID,
+			UserID:     types.BinaryUUID(uuid.New()),
+			StartTime:  at(11).Add(30 * time.Minute),
+			EndTime:    at(12).Add(30 * time.Minute),
+		}
+		Expect(bookingService.CreateBooking(outsideBuffer)).To(BeNil())
+	})
+
+	It("should accept back to back bookings without a buffer", func() {
+		resource := createResource("Back To Back Room")
+		createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+
+		next := &models.Booking{
+			ResourceID: resource.UUID,
+			UserID:     types.BinaryUUID(uuid.New()),
+			StartTime:  at(11),
+			EndTime:    at(12),
+		}
+		Expect(bookingService.CreateBooking(next)).To(BeNil())
+	})
+
+	It("should reject an availability overlapping an existing one", func() {
+		resource := createResource("Overlap Room")
+
+		overlapping := &models.Availability{
+			StartTime: at(16),
+			EndTime:   at(18),
+		}
+		Expect(bookingService.CreateAvailability(resource.UUID, overlapping)).To(MatchError(booking.ErrAvailabilityOverlap))
+	})
+
+	It("should accept an availability not overlapping existing ones", func() {
+		resource := createResource("Adjacent Room")
+
+		adjacent := &models.Availability{
+			StartTime: at(17),
+			EndTime:   at(19),
+		}
+		Expect(bookingService.CreateAvailability(resource.UUID, adjacent)).To(BeNil())
+	})
+
+	It("should reject a one-off availability on a later occurrence of a recurring one", func() {
+		resource := createResource("Recurring Overlap Room")
+
+		// weekly rules without BYDAY repeat on the weekday they start on
+		Expect(bookingService.CreateAvailability(resource.UUID, &models.Availability{
+			StartTime:   at(24 + 9),
+			EndTime:     at(24 + 17),
+			IsRecurring: true,
+			RecurRule:   "FREQ=WEEKLY",
+		})).To(BeNil())
+
+		nextWeek := &models.Availability{
+			StartTime: at(8*24 + 10),
+			EndTime:   at(8*24 + 12),
+		}
+		Expect(bookingService.CreateAvailability(resource.UUID, nextWeek)).To(MatchError(booking.ErrAvailabilityOverlap))
+	})
+
+	It("should accept recurring availabilities on different days of the week", func() {
+		resource := createResource("Disjoint Recurring Room")
+
+		for _, days := range []int{1, 2} {
+			Expect(bookingService.CreateAvailability(resource.UUID, &models.Availability{
+				StartTime:   at(days*24 + 9),
+				EndTime:     at(days*24 + 17),
+				IsRecurring: true,
+				RecurRule:   "FREQ=WEEKLY",
+			})).To(BeNil())
+		}
+	})
+
+	It("should block new bookings while the resource is under maintenance", func() {
+		resource := createResource("Maintenance Room")
+		Expect(bookingService.SetResourceMaintenance(resource.UUID, true)).To(BeNil())
+
+		newBooking := func() *models.Booking {
+			return &models.Booking{
+				ResourceID: resource.UUID,
+				UserID:     types.BinaryUUID(uuid.New()),
+				StartTime:  at(10),
+				EndTime:    at(11),
+			}
+		}
+		Expect(bookingService.CreateBooking(newBooking())).To(MatchError(booking.ErrResourceUnderMaintenance))
+
+		Expect(bookingService.SetResourceMaintenance(resource.UUID, false)).To(BeNil())
+		Expect(bookingService.CreateBooking(newBooking())).To(BeNil())
+	})
+
+	It("should check in and then check out a booking", func() {
+		resource := createResource("Check In Room")
+		b := createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+
+		Expect(bookingService.CheckInBooking(b.UUID)).To(BeNil())
+		Expect(bookingService.CheckInBooking(b.UUID)).To(MatchError(booking.ErrAlreadyCheckedIn))
+		Expect(bookingService.CheckOutBooking(b.UUID)).To(BeNil())
+
+		checkedOut, err := bookingService.GetBookingByID(b.UUID)
+		Expect(err).To(BeNil())
+		Expect(checkedOut.CheckedInAt).NotTo(BeNil())
+		Expect(checkedOut.CheckedOutAt).NotTo(BeNil())
+		Expect(checkedOut.CheckedOutAt.Before(*checkedOut.CheckedInAt)).To(BeFalse())
+	})
+
+	It("should reject checking out a booking before checking in", func() {
+		resource := createResource("Check Out Room")
+		b := createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+
+		Expect(bookingService.CheckOutBooking(b.UUID)).To(MatchError(booking.ErrNotCheckedIn))
+	})
+
+	It("should mark a booking as no-show and free the slot", func() {
+		resource := createResource("No Show Room")
+		b := createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+
+		Expect(bookingService.MarkBookingNoShow(b.UUID)).To(BeNil())
+
+		noShow, err := bookingService.GetBookingByID(b.UUID)
+		Expect(err).To(BeNil())
+		Expect(noShow.Status).To(Equal(constants.BookingStatusNoShow))
+
+		available, err := bookingService.CheckResourceAvailability(resource.UUID, at(10), at(11))
+		Expect(err).To(BeNil())
+		Expect(available).To(BeTrue())
+	})
+
+	It("should not mark a cancelled booking as no-show", func() {
+		resource := createResource("Cancelled No Show Room")
+		b := createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusCancelled)
+
+		Expect(bookingService.MarkBookingNoShow(b.UUID)).To(MatchError(booking.ErrInvalidBookingStatus))
+	})
+
+	DescribeTable("should only let updates cancel bookings holding their slot",
+		func(from, to string, allowed bool) {
+			resource := createResource("Status Update Room " + from + " " + to)
+			b := createBookingWithStatus(resource.UUID, 10, 11, from)
+
+			err := bookingService.UpdateBooking(b.UUID, func(booking *models.Booking) error {
+				booking.Status = to
+				return nil
+			})
+			if allowed {
+				Expect(err).To(BeNil())
+			} else {
+				Expect(err).To(MatchError(booking.ErrInvalidBookingStatus))
+			}
+		},
+		Entry("pending to cancelled", constants.BookingStatusPending, constants.BookingStatusCancelled, true),
+		Entry("confirmed to cancelled", constants.BookingStatusConfirmed, constants.BookingStatusCancelled, true),
+		Entry("confirmed to no-show", constants.BookingStatusConfirmed, constants.BookingStatusNoShow, false),
+		Entry("cancelled to confirmed", constants.BookingStatusCancelled, constants.BookingStatusConfirmed, false),
+		Entry("no-show to cancelled", constants.BookingStatusNoShow, constants.BookingStatusCancelled, false),
+	)
+
+	It("should not check in a no-show booking", func() {
+		resource := createResource("No Show Check In Room")
+		b := createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+		Expect(bookingService.MarkBookingNoShow(b.UUID)).To(BeNil())
+
+		Expect(bookingService.CheckInBooking(b.UUID)).To(MatchError(booking.ErrInvalidBookingStatus))
+	})
+
+	It("should restore a cancelled booking while the slot is free", func() {
+		resource := createResource("Restored Room")
+		b := createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+		Expect(bookingService.CancelBooking(b.UUID)).To(BeNil())
+
+		Expect(bookingService.RestoreBooking(b.UUID)).To(BeNil())
+
+		restored, err := bookingService.GetBookingByID(b.UUID)
+		Expect(err).To(BeNil())
+		Expect(restored.Status).To(Equal(constants.BookingStatusConfirmed))
+
+		Expect(bookingService.RestoreBooking(b.UUID)).To(MatchError(booking.ErrInvalidBookingStatus))
+	})
+
+	It("should not restore a cancelled booking taken over by a newer booking", func() {
+		resource := createResource("Rebooked Room")
+		b := createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+		Expect(bookingService.CancelBooking(b.UUID)).To(BeNil())
+
+		createBookingWithStatus(resource.UUID, 10, 12, constants.BookingStatusConfirmed)
+
+		Expect(bookingService.RestoreBooking(b.UUID)).To(MatchError(booking.ErrResourceNotAvailable))
+
+		cancelled, err := bookingService.GetBookingByID(b.UUID)
+		Expect(err).To(BeNil())
+		Expect(cancelled.Status).To(Equal(constants.BookingStatusCancelled))
+	})
+
+	It("should paginate the availabilities of a resource", func() {
+		resource := createResource("Paginated Room")
+		for i := 1; i <= 11; i++ {
+			next := &models.Availability{
+				StartTime: at(9).AddDate(0, 0, i),
+				EndTime:   at(17).AddDate(0, 0, i),
+			}
+			Expect(bookingService.CreateAvailability(resource.UUID, next)).To(BeNil())
+		}
+
+		firstPage, total, err := bookingService.ListAvailabilitiesByResourceID(resource.UUID, 1, 10)
+		Expect(err).To(BeNil())
+		Expect(total).To(Equal(int64(12)))
+		Expect(firstPage).To(HaveLen(10))
+
+		secondPage, _, err := bookingService.ListAvailabilitiesByResourceID(resource.UUID, 2, 10)
+		Expect(err).To(BeNil())
+		Expect(secondPage).To(HaveLen(2))
+		Expect(secondPage[1].StartTime).To(BeTemporally("~", at(9).AddDate(0, 0, 11), time.Second))
+	})
+
+	It("should generate weekly availabilities and reject overlapping schedules", func() {
+		resource := &models.Resource{
+			Name:     "Weekly Room",
+			Type:     "room",
+			Capacity: 1,
+		}
+		Expect(bookingService.CreateResource(resource)).To(BeNil())
+
+		from := day.AddDate(0, 0, 1)
+		until := from.AddDate(0, 0, 13)
+		generated, err := bookingService.GenerateAvailabilities(
+			resource.UUID, []string{"MO", "WE", "FR"}, "09:00", "12:00", from, until,
+		)
+		Expect(err).To(BeNil())
+		Expect(generated).To(HaveLen(6))
+
+		_, total, err := bookingService.ListAvailabilitiesByResourceID(resource.UUID, 1, 10)
+		Expect(err).To(BeNil())
+		Expect(total).To(Equal(int64(6)))
+
+		_, err = bookingService.GenerateAvailabilities(
+			resource.UUID, []string{"MO", "TU", "WE", "TH", "FR"}, "11:00", "13:00", from, until,
+		)
+		Expect(err).To(MatchError(booking.ErrAvailabilityOverlap))
+	})
+
+	It("should search resources by partial name combined with capacity", func() {
+		small := createResource("Searchable Huddle Space")
+		large := &models.Resource{
+			Name:     "Searchable Board Space",
+			Type:     "room",
+			Capacity: 12,
+		}
+		Expect(bookingService.CreateResource(large)).To(BeNil())
+
+		resources, total, err := bookingService.ListResources(1, 10, "Searchable", nil)
+		Expect(err).To(BeNil())
+		Expect(total).To(Equal(int64(2)))
+		Expect(resources).To(HaveLen(2))
+
+		resources, total, err = bookingService.ListResources(1, 10, "huddle", map[string]interface{}{"capacity": small.Capacity})
+		Expect(err).To(BeNil())
+		Expect(total).To(Equal(int64(1)))
+		Expect(resources[0].UUID).To(Equal(small.UUID))
+
+		_, total, err = bookingService.ListResources(1, 10, "huddle", map[string]interface{}{"capacity": large.Capacity})
+		Expect(err).To(BeNil())
+		Expect(total).To(BeZero())
+	})
+
+	It("should match percent signs and underscores in the search literally", func() {
+		prefix := "Literal " + uuid.NewString()[:8]
+		discounted := createResource(prefix + " 100% Room")
+		createResource(prefix + " 1000 Room")
+
+		resources, total, err := bookingService.ListResources(1, 10, prefix+" 100%", nil)
+		Expect(err).To(BeNil())
+		Expect(total).To(Equal(int64(1)))
+		Expect(resources[0].UUID).To(Equal(discounted.UUID))
+
+		_, total, err = bookingService.ListResources(1, 10, prefix+" 1_00", nil)
+		Expect(err).To(BeNil())
+		Expect(total).To(BeZero())
+	})
+
+	It("should generate unique booking references when not provided", func() {
+		resource := createResource("Reference Room")
+		first := createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusConfirmed)
+		second := createBookingWithStatus(resource.UUID, 12, 13, constants.BookingStatusConfirmed)
+
+		Expect(first.Reference).To(HavePrefix("BK-"))
+		Expect(second.Reference).To(HavePrefix("BK-"))
+		Expect(first.Reference).NotTo(Equal(second.Reference))
+	})
+
+	It("should preserve a provided booking reference and reject duplicates", func() {
+		resource := createResource("Provided Reference Room")
+		reference := "ACME-" + uuid.NewString()[:8]
+
+		provided := &models.Booking{
+			ResourceID: resource.UUID,
+			UserID:     types.BinaryUUID(uuid.New()),
+			StartTime:  at(10),
+			EndTime:    at(11),
+			Reference:  reference,
+		}
+		Expect(bookingService.CreateBooking(provided)).To(BeNil())
+
+		saved, err := bookingService.GetBookingByID(provided.UUID)
+		Expect(err).To(BeNil())
+		Expect(saved.Reference).To(Equal(reference))
+
+		duplicate := &models.Booking{
+			ResourceID: resource.UUID,
+			UserID:     types.BinaryUUID(uuid.New()),
+			StartTime:  at(12),
+			EndTime:    at(13),
+			Reference:  reference,
+		}
+		Expect(bookingService.CreateBooking(duplicate)).To(MatchError(booking.ErrDuplicateBookingReference))
+	})
+
+	It("should reject a generated booking reference that is already in use", func() {
+		resource := createResource("Colliding Reference Room")
+		first := &models.Booking{
+			UUID:       types.BinaryUUID(uuid.New()),
+			ResourceID: resource.UUID,
+			UserID:     types.BinaryUUID(uuid.New()),
+			StartTime:  at(10),
+			EndTime:    at(11),
+		}
+		Expect(bookingService.CreateBooking(first)).To(BeNil())
+
+		// references are generated from the start of the UUID, so sharing it collides
+		id := uuid.New()
+		copy(id[:8], first.UUID[:8])
+		colliding := &models.Booking{
+			UUID:       types.BinaryUUID(id),
+			ResourceID: resource.UUID,
+			UserID:     types.BinaryUUID(uuid.New()),
+			StartTime:  at(12),
+			EndTime:    at(13),
+		}
+		Expect(bookingService.CreateBooking(colliding)).To(MatchError(booking.ErrDuplicateBookingReference))
+	})
+
+	It("should resolve resource names including deleted resources", func() {
+		kept := createResource("Named Room")
+		deleted := createResource("Deleted Named Room")
+		Expect(bookingService.DeleteResource(deleted.UUID)).To(BeNil())
+		unknown := types.BinaryUUID(uuid.New())
+
+		names, err := bookingService.GetResourceNames(kept.UUID, deleted.UUID, unknown)
+		Expect(err).To(BeNil())
+		Expect(names).To(HaveKeyWithValue(kept.UUID, "Named Room"))
+		Expect(names).To(HaveKeyWithValue(deleted.UUID, "Deleted Named Room"))
+		Expect(names).NotTo(HaveKey(unknown))
+	})
+
+	It("should count bookings by status within a date range", func() {
+		resource := createResource("Stats Room")
+
+		// a window far from the other specs so their bookings are not counted
+		windowStart := day.AddDate(1, 0, 0)
+		windowEnd := windowStart.Add(24 * time.Hour)
+		statuses := []string{
+			constants.BookingStatusPending,
+			constants.BookingStatusConfirmed,
+			constants.BookingStatusConfirmed,
+			constants.BookingStatusCancelled,
+		}
+		for i, status := range statuses {
+			start := windowStart.Add(time.Duration(i) * time.Hour)
+			Expect(bookingRepo.CreateBooking(&models.Booking{
+				ResourceID: resource.UUID,
+				UserID:     types.BinaryUUID(uuid.New()),
+				StartTime:  start,
+				EndTime:    start.Add(30 * time.Minute),
+				Status:     status,
+			})).To(BeNil())
+		}
+
+		stats, err := bookingService.GetBookingStats(windowStart, windowEnd)
+		Expect(err).To(BeNil())
+		Expect(stats).To(Equal(map[string]int64{
+			constants.BookingStatusPending:   1,
+			constants.BookingStatusConfirmed: 2,
+			constants.BookingStatusCancelled: 1,
+			constants.BookingStatusCompleted: 0,
+			constants.BookingStatusNoShow:    0,
+		}))
+
+		_, err = bookingService.GetBookingStats(windowEnd, windowStart)
+		Expect(err).To(MatchError(booking.ErrInvalidTimeRange))
+	})
+
+	It("should report the utilization of bookings within a date range", func() {
+		resource := createResource("Analytics Room")
+		other := createResource("Other Analytics Room")
+
+		// a window far from the other specs so their bookings are not counted
+		windowStart := day.AddDate(2, 0, 0)
+		windowEnd := windowStart.AddDate(0, 0, 3)
+		create := func(resourceID types.BinaryUUID, offset, duration time.Duration, status string) {
+			Expect(bookingRepo.CreateBooking(&models.Booking{
+				ResourceID: resourceID,
+				UserID:     types.BinaryUUID(uuid.New()),
+				StartTime:  windowStart.Add(offset),
+				EndTime:    windowStart.Add(offset + duration),
+				Status:     status,
+			})).To(BeNil())
+		}
+		create(resource.UUID, 9*time.Hour, 2*time.Hour, constants.BookingStatusConfirmed)
+		create(resource.UUID, 13*time.Hour, 30*time.Minute, constants.BookingStatusCompleted)
+		create(resource.UUID, 15*time.Hour, time.Hour, constants.BookingStatusCancelled)
+		create(resource.UUID, 48*time.Hour+10*time.Hour, time.Hour, constants.BookingStatusPending)
+		create(other.UUID, 24*time.Hour+10*time.Hour, 4*time.Hour, constants.BookingStatusConfirmed)
+		create(resource.UUID, 72*time.Hour+10*time.Hour, time.Hour, constants.BookingStatusConfirmed)
+
+		dayKey := func(offset int) string {
+			return windowStart.AddDate(0, 0, offset).Format(time.DateOnly)
+		}
+
+		analytics, err := bookingService.GetBookingAnalytics(windowStart, windowEnd, &resource.UUID)
+		Expect(err).To(BeNil())
+		Expect(analytics.TotalBookings).To(Equal(int64(3)))
+		Expect(analytics.TotalBookedHours).To(BeNumerically("~", 3.5))
+		Expect(analytics.ByDay).To(Equal([]booking.BookingDayCount{
+			{Day: dayKey(0), Count: 2},
+			{Day: dayKey(1), Count: 0},
+			{Day: dayKey(2), Count: 1},
+		}))
+
+		analytics, err = bookingService.GetBookingAnalytics(windowStart, windowEnd, nil)
+		Expect(err).To(BeNil())
+		Expect(analytics.TotalBookings).To(Equal(int64(4)))
+		Expect(analytics.TotalBookedHours).To(BeNumerically("~", 7.5))
+		Expect(analytics.ByDay[1].Count).To(Equal(int64(1)))
+
+		_, err = bookingService.GetBookingAnalytics(windowEnd, windowStart, nil)
+		Expect(err).To(MatchError(booking.ErrInvalidTimeRange))
+	})
+
+	It("should group the overlapping bookings of a resource", func() {
+		resource := createResource("Imported Room")
+		first := createBookingWithStatus(resource.UUID, 9, 11, constants.BookingStatusConfirmed)
+		second := createBookingWithStatus(resource.UUID, 10, 12, constants.BookingStatusPending)
+		createBookingWithStatus(resource.UUID, 10, 11, constants.BookingStatusCancelled)
+		createBookingWithStatus(resource.UUID, 13, 14, constants.BookingStatusConfirmed)
+		third := createBookingWithStatus(resource.UUID, 15, 17, constants.BookingStatusConfirmed)
+		fourth := createBookingWithStatus(resource.UUID, 16, 18, constants.BookingStatusConfirmed)
+
+		groups, err := bookingService.GetResourceConflicts(resource.UUID, at(0), at(24))
+		Expect(err).To(BeNil())
+		Expect(groups).To(HaveLen(2))
+
+		uuids := func(index int) []types.BinaryUUID {
+			var ids []types.BinaryUUID
+			for _, b := range groups[index].Bookings {
+				ids = append(ids, b.UUID)
+			}
+			return ids
+		}
+		Expect(uuids(0)).To(Equal([]types.BinaryUUID{first.UUID, second.UUID}))
+		Expect(uuids(1)).To(Equal([]types.BinaryUUID{third.UUID, fourth.UUID}))
+
+		_, err = bookingService.GetResourceConflicts(resource.UUID, at(24), at(0))
+		Expect(err).To(MatchError(booking.ErrInvalidTimeRange))
+	})
+
+	Context("with a seat based resource", func() {
+		var resource *models.Resource
+
+		BeforeEach(func() {
+			resource = &models.Resource{
+				Name:      "Group Room",
+				Type:      "room",
+				Capacity:  4,
+				SeatBased: true,
+			}
+			Expect(bookingService.CreateResource(resource)).To(BeNil())
+			Expect(bookingService.CreateAvailability(resource.UUID, &models.Availability{
+				StartTime: at(9),
+				EndTime:   at(17),
+			})).To(BeNil())
+		})
+
+		groupBooking := func(from, to, seats int) *models.Booking {
+			return &models.Booking{
+				ResourceID: resource.UUID,
+				UserID:     types.BinaryUUID(uuid.New()),
+				StartTime:  at(from),
+				EndTime:    at(to),
+				Seats:      seats,
+			}
+		}
+
+		It("should accept overlapping bookings filling the capacity exactly", func() {
+			Expect(bookingService.CreateBooking(groupBooking(10, 12, 3))).To(BeNil())
+			Expect(bookingService.CreateBooking(groupBooking(11, 13, 1))).To(BeNil())
+		})
+
+		It("should reject a booking exceeding the capacity combined with overlapping bookings", func() {
+			Expect(bookingService.CreateBooking(groupBooking(10, 12, 3))).To(BeNil())
+			Expect(bookingService.CreateBooking(groupBooking(11, 13, 2))).To(MatchError(booking.ErrResourceNotAvailable))
+
+			// the seats are free again once the first booking is over
+			Expect(bookingService.CreateBooking(groupBooking(13, 14, 2))).To(BeNil())
+		})
+
+		It("should reject a booking requesting more seats than the capacity", func() {
+			Expect(bookingService.CreateBooking(groupBooking(10, 11, 5))).To(MatchError(booking.ErrExceedsCapacity))
+		})
+
+		It("should default to a single seat", func() {
+			b := groupBooking(10, 11, 0)
+			Expect(bookingService.CreateBooking(b)).To(BeNil())
+			Expect(b.Seats).To(Equal(1))
+		})
+
+		It("should keep bookings exclusive when the resource is not seat based", func() {
+			Expect(bookingService.UpdateResource(resource.UUID, func(r *models.Resource) error {
+				r.SeatBased = false
+				return nil
+			})).To(BeNil())
+
+			Expect(bookingService.CreateBooking(groupBooking(10, 12, 1))).To(BeNil())
+			Expect(bookingService.CreateBooking(groupBooking(11, 13, 1))).To(MatchError(booking.ErrResourceNotAvailable))
+			Expect(bookingService.CreateBooking(groupBooking(13, 14, 2))).To(MatchError(booking.ErrExceedsCapacity))
+		})
+	})
+})
@@ -0,0 +1,35 @@
+package constants
+
+// Booking statuses
+//
+// Pending and confirmed bookings occupy the resource for their time range,
+// cancelled, completed and no-show bookings free it up again.
+const (
+	BookingStatusPending   = "pending"
+	BookingStatusConfirmed = "confirmed"
+	BookingStatusCancelled = "cancelled"
+	BookingStatusCompleted = "completed"
+	BookingStatusNoShow    = "no-show"
+)
+
+// BookingStatuses are all the valid booking statuses
+var BookingStatuses = []string{
+	BookingStatusPending,
+	BookingStatusConfirmed,
+	BookingStatusCancelled,
+	BookingStatusCompleted,
+	BookingStatusNoShow,
+}
+
+// BookingOccupyingStatuses are the booking statuses that block the resource for other bookings
+var BookingOccupyingStatuses = []string{
+	BookingStatusPending,
+	BookingStatusConfirmed,
+}
+
+// BookingUtilizingStatuses are the booking statuses counted towards the utilization of a resource
+var BookingUtilizingStatuses = []string{
+	BookingStatusPending,
+	BookingStatusConfirmed,
+	BookingStatusCompleted,
+}
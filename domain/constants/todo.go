@@ -0,0 +1,18 @@
+package constants
+
+// Todo statuses
+//
+// Open todos are still to be done, done todos are stamped with their completion time
+// and archived todos are hidden from the daily work but kept around.
+const (
+	TodoStatusOpen     = "open"
+	TodoStatusDone     = "done"
+	TodoStatusArchived = "archived"
+)
+
+// TodoStatuses are all the valid todo statuses
+var TodoStatuses = []string{
+	TodoStatusOpen,
+	TodoStatusDone,
+	TodoStatusArchived,
+}
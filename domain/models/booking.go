@@ -2,6 +2,7 @@ package models
 
 import (
 	"clean-architecture/pkg/types"
+	"encoding/base32"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,7 +19,14 @@ type Booking struct {
 	EndTime    time.Time        `json:"end_time" gorm:"not null;index"`
 	Status     string           `json:"status" gorm:"size:50;default:'pending'"`
 	Notes      string           `json:"notes" gorm:"type:text"`
-	Reference  string           `json:"reference" gorm:"size:100"`
+	Reference  string           `json:"reference" gorm:"size:100;uniqueIndex"`
+
+	// Seats is the share of the resource capacity taken by the booking
+	Seats int `json:"seats" gorm:"default:1"`
+
+	// CheckedInAt and CheckedOutAt track actual usage of the booked resource
+	CheckedInAt  *time.Time `json:"checked_in_at"`
+	CheckedOutAt *time.Time `json:"checked_out_at"`
 }
 
 // BeforeCreate will set a UUID rather than numeric ID
@@ -30,5 +38,13 @@ func (b *Booking) BeforeCreate(tx *gorm.DB) error {
 		}
 		b.UUID = types.BinaryUUID(id)
 	}
+	if b.Reference == "" {
+		b.Reference = newBookingReference(b.UUID)
+	}
 	return nil
 }
+
+// newBookingReference builds a short reference users can quote to support, e.g. BK-7MZQ4XK2T9PLA
+func newBookingReference(id types.BinaryUUID) string {
+	return "BK-" + base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(id[:8])
+}
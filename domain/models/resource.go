@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"clean-architecture/pkg/types"
 
 	"github.com/google/uuid"
@@ -18,6 +20,15 @@ type Resource struct {
 	Capacity    int              `json:"capacity" gorm:"default:1"`
 	Location    string           `json:"location" gorm:"size:255"`
 	Attributes  datatypes.JSON   `json:"attributes" gorm:"type:json"`
+
+	// BufferMinutes is the turnover gap kept free before and after every booking
+	BufferMinutes int `json:"buffer_minutes" gorm:"default:0"`
+
+	// UnderMaintenance blocks new bookings while keeping existing ones
+	UnderMaintenance bool `json:"under_maintenance" gorm:"default:false"`
+
+	// SeatBased lets overlapping bookings share the capacity as seats
+	SeatBased bool `json:"seat_based" gorm:"default:false"`
 }
 
 // BeforeCreate will set a UUID rather than numeric ID
@@ -31,3 +42,17 @@ func (r *Resource) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// SeatCapacity returns the number of seats overlapping bookings can share, at least one
+// resources that are not seat based have a single seat, so their bookings stay exclusive
+func (r *Resource) SeatCapacity() int {
+	if !r.SeatBased {
+		return 1
+	}
+	return max(r.Capacity, 1)
+}
+
+// Buffer returns the turnover gap kept free around bookings
+func (r *Resource) Buffer() time.Duration {
+	return time.Duration(r.BufferMinutes) * time.Minute
+}
@@ -11,6 +11,9 @@ type Todo struct {
 	ID          types.BinaryUUID `json:"id" gorm:"type:binary(16);primary_key"`
 	Title       string           `json:"title" gorm:"not null"`
 	Description string           `json:"description"`
+	Status      string           `json:"status" gorm:"size:20;default:'open';index"`
+	CompletedAt *time.Time       `json:"completed_at"`
+	ArchivedAt  *time.Time       `json:"archived_at"`
 	CreatedAt   time.Time        `json:"created_at"`
 	UpdatedAt   time.Time        `json:"updated_at"`
 }
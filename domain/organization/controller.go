@@ -3,8 +3,8 @@ package organization
 import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/responses"
+	"clean-architecture/pkg/utils"
 	"net/http"
-	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -31,7 +31,7 @@ func (c *Controller) Create(ctx *gin.Context) {
 		return
 	}
 
-	response, err := c.service.Create(request)
+	response, err := c.serviceFor(ctx).Create(request)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -51,7 +51,7 @@ func (c *Controller) Create(ctx *gin.Context) {
 func (c *Controller) GetByID(ctx *gin.Context) {
 	orgID := ctx.Param("id")
 
-	response, err := c.service.GetByID(orgID)
+	response, err := c.serviceFor(ctx).GetByID(orgID)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -67,6 +67,24 @@ func (c *Controller) GetByID(ctx *gin.Context) {
 	)
 }
 
+// Stats handles fetching the organization statistics
+func (c *Controller) Stats(ctx *gin.Context) {
+	stats, err := c.serviceFor(ctx).Stats()
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[OrganizationStats]{
+			Item:    stats,
+			Message: "success",
+		},
+	)
+}
+
 // Update handles updating an organization
 func (c *Controller) Update(ctx *gin.Context) {
 	orgID := ctx.Param("id")
@@ -77,7 +95,7 @@ func (c *Controller) Update(ctx *gin.Context) {
 		return
 	}
 
-	response, err := c.service.Update(orgID, request)
+	response, err := c.serviceFor(ctx).Update(orgID, request)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -95,20 +113,15 @@ func (c *Controller) Update(ctx *gin.Context) {
 
 // List handles fetching a paginated list of organizations
 func (c *Controller) List(ctx *gin.Context) {
-	pageStr := ctx.DefaultQuery("page", "1")
-	limitStr := ctx.DefaultQuery("limit", "10")
-
-	page, err := strconv.Atoi(pageStr)
-	if err != nil || page < 1 {
-		page = 1
-	}
-
-	limit, err := strconv.Atoi(limitStr)
-	if err != nil || limit < 1 || limit > 100 {
-		limit = 10
+	// Parse pagination parameters
+	pagination, err := utils.ParsePagination(ctx)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
 	}
+	page, limit := pagination.Page, pagination.Limit
 
-	organizations, total, err := c.service.List(page, limit)
+	organizations, total, err := c.serviceFor(ctx).List(page, limit)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -123,11 +136,8 @@ func (c *Controller) List(ctx *gin.Context) {
 	}
 
 	response := OrganizationListResponse{
-		Items: items,
-		Pagination: responses.PaginationResponseType{
-			Total:   total,
-			HasNext: (int64(page*limit) < total),
-		},
+		Items:      items,
+		Pagination: responses.NewPagination(page, limit, total),
 	}
 
 	responses.ListResponse(
@@ -136,3 +146,8 @@ func (c *Controller) List(ctx *gin.Context) {
 		response,
 	)
 }
+
+// serviceFor returns the service running its queries with the request context, which carries the request timeout
+func (c *Controller) serviceFor(ctx *gin.Context) *Service {
+	return c.service.WithContext(ctx.Request.Context())
+}
@@ -0,0 +1,52 @@
+package organization_test
+
+import (
+	"clean-architecture/domain/organization"
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/middlewares"
+	"clean-architecture/testutil"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/steinfletcher/apitest"
+	"go.uber.org/fx"
+)
+
+var _ = Describe("Domain/Organization/Controller", Ordered, func() {
+	var (
+		organizationController *organization.Controller
+		logger                 framework.Logger
+	)
+
+	BeforeAll(func() {
+		err := testutil.DI(t,
+			fx.Populate(&organizationController),
+			fx.Populate(&logger),
+		)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	It("should time out listing and stats once the request deadline passes", func() {
+		timedOut := infrastructure.Router{Engine: gin.New()}
+		middlewares.NewTimeoutMiddleware(logger, timedOut, &framework.Env{
+			RequestTimeout: time.Nanosecond,
+		}).Setup()
+		timedOut.GET("/api/organizations", organizationController.List)
+		timedOut.GET("/api/organizations/stats", organizationController.Stats)
+
+		for _, path := range []string{"/api/organizations", "/api/organizations/stats"} {
+			apitest.
+				New().
+				Handler(timedOut).
+				Get(path).
+				Expect(t).
+				Status(http.StatusGatewayTimeout).
+				End()
+		}
+	})
+})
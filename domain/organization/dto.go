@@ -37,6 +37,18 @@ type PageInfo struct {
 	Total   int64 `json:"total"`
 }
 
+// LocationCount DTO for the number of organizations in a location
+type LocationCount struct {
+	Location string `json:"location"`
+	Count    int64  `json:"count"`
+}
+
+// OrganizationStats DTO for organization statistics
+type OrganizationStats struct {
+	Total      int64           `json:"total"`
+	ByLocation []LocationCount `json:"by_location"`
+}
+
 // UpdateOrganizationRequest DTO for updating an organization
 type UpdateOrganizationRequest struct {
 	Name          *string `json:"name"`
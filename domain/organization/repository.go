@@ -5,6 +5,8 @@ import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
 	"clean-architecture/pkg/types"
+	"clean-architecture/pkg/utils"
+	"context"
 )
 
 // Repository database structure
@@ -18,6 +20,13 @@ func NewRepository(db infrastructure.Database, logger framework.Logger) *Reposit
 	return &Repository{db, logger}
 }
 
+// WithContext returns a copy of the repository running its queries with the context
+func (r *Repository) WithContext(ctx context.Context) *Repository {
+	scoped := *r
+	scoped.Database.DB = r.DB.WithContext(ctx)
+	return &scoped
+}
+
 // Create creates a new organization
 func (r *Repository) Create(org *models.Organization) error {
 	r.logger.Info("[OrganizationRepository...Create]")
@@ -36,6 +45,28 @@ func (r *Repository) Update(org *models.Organization) error {
 	return r.DB.Save(org).Error
 }
 
+// CountByLocation counts organizations grouped by location
+func (r *Repository) CountByLocation() (counts []LocationCount, err error) {
+	r.logger.Info("[OrganizationRepository...CountByLocation]")
+	err = r.DB.Model(&models.Organization{}).
+		Select("location, COUNT(*) AS count").
+		Group("location").
+		Order("count DESC, location ASC").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// Search returns up to limit organizations whose name or location contains the term
+func (r *Repository) Search(term string, limit int) (orgs []models.Organization, err error) {
+	r.logger.Info("[OrganizationRepository...Search]")
+	pattern := utils.ContainsPattern(term)
+	err = r.DB.Where("name LIKE ? ESCAPE ? OR location LIKE ? ESCAPE ?", pattern, utils.LikeEscape, pattern, utils.LikeEscape).
+		Order("name ASC").
+		Limit(limit).
+		Find(&orgs).Error
+	return orgs, err
+}
+
 // List returns organizations with pagination
 func (r *Repository) List(page, limit int) (orgs []models.Organization, total int64, err error) {
 	r.logger.Info("[OrganizationRepository...List]")
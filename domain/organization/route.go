@@ -3,6 +3,9 @@ package organization
 import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/openapi"
+	"clean-architecture/pkg/responses"
+	"net/http"
 )
 
 // Route struct
@@ -10,6 +13,7 @@ type Route struct {
 	logger     framework.Logger
 	handler    infrastructure.Router
 	controller *Controller
+	spec       *openapi.Spec
 }
 
 // NewRoute creates a new route
@@ -17,11 +21,13 @@ func NewRoute(
 	logger framework.Logger,
 	handler infrastructure.Router,
 	controller *Controller,
+	spec *openapi.Spec,
 ) *Route {
 	return &Route{
 		handler:    handler,
 		logger:     logger,
 		controller: controller,
+		spec:       spec,
 	}
 }
 
@@ -30,6 +36,42 @@ func RegisterRoutes(r *Route) {
 	api := r.handler.Group("/api/organizations")
 	api.POST("", r.controller.Create)
 	api.GET("", r.controller.List)
+	api.GET("/stats", r.controller.Stats)
 	api.GET("/:id", r.controller.GetByID)
 	api.PUT("/:id", r.controller.Update)
+
+	describeRoutes(r.spec)
+}
+
+// describeRoutes documents the organization routes in the openapi spec
+func describeRoutes(spec *openapi.Spec) {
+	spec.Describe(http.MethodPost, "/api/organizations", openapi.Operation{
+		Summary:  "Create organization",
+		Tag:      "organization",
+		Request:  CreateOrganizationRequest{},
+		Response: responses.DetailResponseType[OrganizationResponse]{},
+		Status:   http.StatusCreated,
+	})
+	spec.Describe(http.MethodGet, "/api/organizations", openapi.Operation{
+		Summary:  "List organizations",
+		Tag:      "organization",
+		Query:    openapi.PaginationQuery{},
+		Response: OrganizationListResponse{},
+	})
+	spec.Describe(http.MethodGet, "/api/organizations/stats", openapi.Operation{
+		Summary:  "Count organizations by location",
+		Tag:      "organization",
+		Response: responses.DetailResponseType[OrganizationStats]{},
+	})
+	spec.Describe(http.MethodGet, "/api/organizations/:id", openapi.Operation{
+		Summary:  "Get organization",
+		Tag:      "organization",
+		Response: responses.DetailResponseType[OrganizationResponse]{},
+	})
+	spec.Describe(http.MethodPut, "/api/organizations/:id", openapi.Operation{
+		Summary:  "Update organization",
+		Tag:      "organization",
+		Request:  UpdateOrganizationRequest{},
+		Response: responses.DetailResponseType[OrganizationResponse]{},
+	})
 }
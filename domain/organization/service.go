@@ -4,6 +4,7 @@ import (
 	"clean-architecture/domain/models"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/types"
+	"context"
 	"time"
 )
 
@@ -18,6 +19,13 @@ func NewService(repo *Repository, logger framework.Logger) *Service {
 	return &Service{repo, logger}
 }
 
+// WithContext returns a copy of the service running its queries with the context
+func (s *Service) WithContext(ctx context.Context) *Service {
+	scoped := *s
+	scoped.repo = s.repo.WithContext(ctx)
+	return &scoped
+}
+
 // Create creates a new organization
 func (s *Service) Create(request CreateOrganizationRequest) (OrganizationResponse, error) {
 	s.logger.Info("[OrganizationService...Create]")
@@ -59,7 +67,7 @@ func (s *Service) GetByID(orgID string) (OrganizationResponse, error) {
 	// Convert string ID to BinaryUUID
 	id, err := types.ShouldParseUUID(orgID)
 	if err != nil {
-		return OrganizationResponse{}, ErrInvalidOrganizationData
+		return OrganizationResponse{}, err
 	}
 
 	// Get from database
@@ -86,7 +94,7 @@ func (s *Service) Update(orgID string, request UpdateOrganizationRequest) (Organ
 	// Convert string ID to BinaryUUID
 	id, err := types.ShouldParseUUID(orgID)
 	if err != nil {
-		return OrganizationResponse{}, ErrInvalidOrganizationData
+		return OrganizationResponse{}, err
 	}
 
 	// Get existing organization
@@ -127,6 +135,28 @@ func (s *Service) Update(orgID string, request UpdateOrganizationRequest) (Organ
 	}, nil
 }
 
+// Stats returns the total number of organizations and the count per location
+func (s *Service) Stats() (OrganizationStats, error) {
+	s.logger.Info("[OrganizationService...Stats]")
+
+	locations, err := s.repo.CountByLocation()
+	if err != nil {
+		return OrganizationStats{}, err
+	}
+
+	stats := OrganizationStats{ByLocation: locations}
+	for _, location := range locations {
+		stats.Total += location.Count
+	}
+	return stats, nil
+}
+
+// Search returns up to limit organizations matching the term by name or location
+func (s *Service) Search(term string, limit int) ([]models.Organization, error) {
+	s.logger.Info("[OrganizationService...Search]")
+	return s.repo.Search(term, limit)
+}
+
 // List returns a paginated list of organizations
 func (s *Service) List(page, limit int) ([]models.Organization, int64, error) {
 	s.logger.Info("[OrganizationService...List]")
@@ -0,0 +1,83 @@
+package organization_test
+
+import (
+	"clean-architecture/domain/models"
+	"clean-architecture/domain/organization"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx"
+)
+
+var _ = Describe("Domain/Organization/Service", Ordered, func() {
+	var (
+		organizationService *organization.Service
+		db                  infrastructure.Database
+	)
+
+	BeforeAll(func() {
+		err := testutil.DI(t,
+			fx.Populate(&organizationService),
+			fx.Populate(&db),
+		)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	BeforeEach(func() {
+		Expect(testutil.TruncateTables(db.DB, &models.Organization{})).To(Succeed())
+	})
+
+	It("should count organizations in total and per location", func() {
+		for _, location := range []string{"Kathmandu", "Kathmandu", "Pokhara", "Kathmandu", "Lalitpur", "Pokhara"} {
+			_, err := organizationService.Create(organization.CreateOrganizationRequest{
+				Name:     "Organization in " + location,
+				Location: location,
+			})
+			Expect(err).To(BeNil())
+		}
+
+		stats, err := organizationService.Stats()
+		Expect(err).To(BeNil())
+		Expect(stats.Total).To(Equal(int64(6)))
+		Expect(stats.ByLocation).To(Equal([]organization.LocationCount{
+			{Location: "Kathmandu", Count: 3},
+			{Location: "Pokhara", Count: 2},
+			{Location: "Lalitpur", Count: 1},
+		}))
+	})
+
+	It("should search organizations by name or location", func() {
+		for _, request := range []organization.CreateOrganizationRequest{
+			{Name: "Himalayan Trekkers", Location: "Pokhara"},
+			{Name: "Valley Builders", Location: "Kathmandu"},
+			{Name: "Lakeside Rentals", Location: "Pokhara"},
+		} {
+			_, err := organizationService.Create(request)
+			Expect(err).To(BeNil())
+		}
+
+		orgs, err := organizationService.Search("pokhara", 10)
+		Expect(err).To(BeNil())
+		Expect(orgs).To(HaveLen(2))
+		Expect(orgs[0].Name).To(Equal("Himalayan Trekkers"))
+
+		orgs, err = organizationService.Search("Builders", 10)
+		Expect(err).To(BeNil())
+		Expect(orgs).To(HaveLen(1))
+
+		orgs, err = organizationService.Search("pokhara", 1)
+		Expect(err).To(BeNil())
+		Expect(orgs).To(HaveLen(1))
+	})
+
+	It("should report zero without organizations", func() {
+		stats, err := organizationService.Stats()
+		Expect(err).To(BeNil())
+		Expect(stats.Total).To(BeZero())
+		Expect(stats.ByLocation).To(BeEmpty())
+	})
+})
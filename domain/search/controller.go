@@ -0,0 +1,78 @@
+package search
+
+import (
+	"net/http"
+
+	"clean-architecture/domain/user"
+	"clean-architecture/pkg/errorz"
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/responses"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Controller handles HTTP requests for the search
+type Controller struct {
+	logger      framework.Logger
+	service     *Service
+	userService *user.Service
+}
+
+// NewController creates a new search controller
+func NewController(logger framework.Logger, service *Service, userService *user.Service) *Controller {
+	return &Controller{
+		logger:      logger,
+		service:     service,
+		userService: userService,
+	}
+}
+
+// Search handles searching across domains, available to admins only
+func (c *Controller) Search(ctx *gin.Context) {
+	c.logger.Info("[SearchController...Search]")
+
+	identity, err := c.userService.WithContext(ctx.Request.Context()).GetIdentity(ctx.GetString(framework.UID))
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+	if !identity.IsAdmin {
+		responses.HandleError(ctx, c.logger, errorz.ErrForbidden)
+		return
+	}
+
+	var query QueryParams
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
+	}
+
+	types, err := ParseTypes(query.Types)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	results, err := c.serviceFor(ctx).Search(query.Query, types, query.Limit)
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
+	}
+
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[Response]{
+			Item: Response{
+				Items: results,
+				Total: len(results),
+			},
+			Message: "success",
+		},
+	)
+}
+
+// serviceFor returns the service running its queries with the request context, which carries the request timeout
+func (c *Controller) serviceFor(ctx *gin.Context) *Service {
+	return c.service.WithContext(ctx.Request.Context())
+}
@@ -0,0 +1,32 @@
+package search
+
+// Result types returned by the search
+const (
+	TypeResource     = "resource"
+	TypeOrganization = "org"
+)
+
+// Types lists every searchable result type in the order results are merged
+var Types = []string{TypeResource, TypeOrganization}
+
+// QueryParams for the search request
+// types is a comma separated subset of Types and limit applies to each type separately
+type QueryParams struct {
+	Query string `form:"q" binding:"required"`
+	Types string `form:"types"`
+	Limit int    `form:"limit"`
+}
+
+// Result is a single match tagged with the domain it comes from
+type Result struct {
+	Type        string `json:"type"`
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// Response for the search request
+type Response struct {
+	Items []Result `json:"items"`
+	Total int      `json:"total"`
+}
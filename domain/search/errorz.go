@@ -0,0 +1,8 @@
+package search
+
+import "clean-architecture/pkg/errorz"
+
+var (
+	// ErrInvalidSearchType is returned when an unknown result type is requested
+	ErrInvalidSearchType = errorz.ErrBadRequest.JoinError("invalid search type")
+)
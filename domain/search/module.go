@@ -0,0 +1,13 @@
+package search
+
+import "go.uber.org/fx"
+
+// Module provides search dependencies
+var Module = fx.Module("search",
+	fx.Provide(
+		NewService,
+		NewController,
+		NewRoute,
+	),
+	fx.Invoke(RegisterRoute),
+)
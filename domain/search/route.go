@@ -0,0 +1,46 @@
+package search
+
+import (
+	"net/http"
+
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/openapi"
+	"clean-architecture/pkg/responses"
+)
+
+// Route struct
+type Route struct {
+	logger     framework.Logger
+	handler    infrastructure.Router
+	controller *Controller
+	spec       *openapi.Spec
+}
+
+// NewRoute creates a new search route
+func NewRoute(
+	logger framework.Logger,
+	handler infrastructure.Router,
+	controller *Controller,
+	spec *openapi.Spec,
+) *Route {
+	return &Route{
+		logger:     logger,
+		handler:    handler,
+		controller: controller,
+		spec:       spec,
+	}
+}
+
+// RegisterRoute registers the search route
+func RegisterRoute(r *Route) {
+	r.logger.Info("Setting up search routes")
+	r.handler.GET("/api/search", r.controller.Search)
+
+	r.spec.Describe(http.MethodGet, "/api/search", openapi.Operation{
+		Summary:  "Search resources and organizations",
+		Tag:      "search",
+		Query:    QueryParams{},
+		Response: responses.DetailResponseType[Response]{},
+	})
+}
@@ -0,0 +1,20 @@
+package search_test
+
+import (
+	"clean-architecture/pkg/utils"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSearch(t *testing.T) {
+	utils.ChDir()
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Search Suite")
+}
+
+var t GinkgoTInterface
+var _ = BeforeSuite(func() {
+	t = GinkgoT()
+})
@@ -0,0 +1,125 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"clean-architecture/domain/booking"
+	"clean-architecture/domain/organization"
+	"clean-architecture/pkg/framework"
+)
+
+// Service searches across the resource and organization domains
+type Service struct {
+	logger       framework.Logger
+	bookings     *booking.Service
+	organization *organization.Service
+}
+
+// NewService creates a new search service
+func NewService(
+	logger framework.Logger,
+	bookings *booking.Service,
+	organization *organization.Service,
+) *Service {
+	return &Service{
+		logger:       logger,
+		bookings:     bookings,
+		organization: organization,
+	}
+}
+
+// WithContext returns a copy of the service running the domain queries with the context
+func (s *Service) WithContext(ctx context.Context) *Service {
+	scoped := *s
+	scoped.bookings = s.bookings.WithContext(ctx)
+	scoped.organization = s.organization.WithContext(ctx)
+	return &scoped
+}
+
+// ParseTypes splits a comma separated list of result types, defaulting to every type when empty
+func ParseTypes(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return Types, nil
+	}
+
+	var types []string
+	for _, part := range strings.Split(value, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if !slices.Contains(Types, part) {
+			return nil, fmt.Errorf("%w: %q, expected one of %s", ErrInvalidSearchType, part, strings.Join(Types, ", "))
+		}
+		if !slices.Contains(types, part) {
+			types = append(types, part)
+		}
+	}
+	return types, nil
+}
+
+// Search returns up to limit matches of the term for each of the given types
+func (s *Service) Search(term string, types []string, limit int) ([]Result, error) {
+	s.logger.Info("[SearchService...Search]")
+
+	if limit < 1 || limit > 50 {
+		limit = 10 // Default limit
+	}
+
+	results := []Result{}
+	for _, resultType := range types {
+		var (
+			matches []Result
+			err     error
+		)
+		switch resultType {
+		case TypeResource:
+			matches, err = s.searchResources(term, limit)
+		case TypeOrganization:
+			matches, err = s.searchOrganizations(term, limit)
+		default:
+			err = fmt.Errorf("%w: %q", ErrInvalidSearchType, resultType)
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, matches...)
+	}
+	return results, nil
+}
+
+func (s *Service) searchResources(term string, limit int) ([]Result, error) {
+	resources, _, err := s.bookings.ListResources(1, limit, term, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(resources))
+	for i, resource := range resources {
+		results[i] = Result{
+			Type:        TypeResource,
+			ID:          resource.UUID.String(),
+			Title:       resource.Name,
+			Description: resource.Description,
+		}
+	}
+	return results, nil
+}
+
+func (s *Service) searchOrganizations(term string, limit int) ([]Result, error) {
+	orgs, err := s.organization.Search(term, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(orgs))
+	for i, org := range orgs {
+		results[i] = Result{
+			Type:        TypeOrganization,
+			ID:          org.ID.String(),
+			Title:       org.Name,
+			Description: org.Location,
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,91 @@
+package search_test
+
+import (
+	"clean-architecture/domain/booking"
+	"clean-architecture/domain/models"
+	"clean-architecture/domain/organization"
+	"clean-architecture/domain/search"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx"
+)
+
+var _ = Describe("Domain/Search/ParseTypes", func() {
+	It("should default to every type", func() {
+		Expect(search.ParseTypes("")).To(Equal(search.Types))
+	})
+
+	It("should accept a subset ignoring case, spaces and duplicates", func() {
+		Expect(search.ParseTypes(" ORG, org")).To(Equal([]string{search.TypeOrganization}))
+	})
+
+	It("should reject unknown types", func() {
+		_, err := search.ParseTypes("resource,content")
+		Expect(err).To(MatchError(search.ErrInvalidSearchType))
+	})
+})
+
+var _ = Describe("Domain/Search/Service", Ordered, func() {
+	var (
+		searchService       *search.Service
+		bookingService      *booking.Service
+		organizationService *organization.Service
+		db                  infrastructure.Database
+	)
+
+	BeforeAll(func() {
+		err := testutil.DI(t,
+			fx.Populate(&searchService),
+			fx.Populate(&bookingService),
+			fx.Populate(&organizationService),
+			fx.Populate(&db),
+		)
+		if err != nil {
+			t.Error(err)
+		}
+
+		Expect(testutil.TruncateTables(db.DB, &models.Resource{}, &models.Organization{})).To(Succeed())
+
+		for _, name := range []string{"Everest Meeting Room", "Everest Projector", "Annapurna Hall"} {
+			Expect(bookingService.CreateResource(&models.Resource{Name: name, Type: "room"})).To(Succeed())
+		}
+		for _, name := range []string{"Everest Outfitters", "Lumbini Traders"} {
+			_, err := organizationService.Create(organization.CreateOrganizationRequest{Name: name})
+			Expect(err).To(BeNil())
+		}
+	})
+
+	It("should return matches from both domains tagged by type", func() {
+		results, err := searchService.Search("everest", search.Types, 10)
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(3))
+
+		countByType := map[string]int{}
+		for _, result := range results {
+			Expect(result.Title).To(ContainSubstring("Everest"))
+			countByType[result.Type]++
+		}
+		Expect(countByType).To(Equal(map[string]int{
+			search.TypeResource:     2,
+			search.TypeOrganization: 1,
+		}))
+	})
+
+	It("should apply the limit to each type", func() {
+		results, err := searchService.Search("everest", search.Types, 1)
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Type).To(Equal(search.TypeResource))
+		Expect(results[1].Type).To(Equal(search.TypeOrganization))
+	})
+
+	It("should only search the requested types", func() {
+		results, err := searchService.Search("everest", []string{search.TypeOrganization}, 10)
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Title).To(Equal("Everest Outfitters"))
+	})
+})
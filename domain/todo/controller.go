@@ -1,14 +1,15 @@
 package todo
 
 import (
+	"fmt"
 	"net/http"
-	"strconv"
 	"time"
 
 	"clean-architecture/domain/models"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/responses"
 	"clean-architecture/pkg/types"
+	"clean-architecture/pkg/utils"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -58,18 +59,12 @@ func (c *Controller) CreateTodo(ctx *gin.Context) {
 		UpdatedAt:   time.Now(),
 	}
 
-	if err := c.service.Create(todo); err != nil {
+	if err := c.serviceFor(ctx).Create(todo); err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
-	todoResponse := TodoResponse{
-		ID:          todo.ID.String(),
-		Title:       todo.Title,
-		Description: todo.Description,
-		CreatedAt:   todo.CreatedAt,
-		UpdatedAt:   todo.UpdatedAt,
-	}
+	todoResponse := TodoToResponse(todo)
 
 	responses.DetailResponse(
 		ctx,
@@ -87,23 +82,17 @@ func (c *Controller) GetTodoByID(ctx *gin.Context) {
 
 	parsedID, err := types.ShouldParseUUID(todoID)
 	if err != nil {
-		responses.HandleValidationError(ctx, c.logger, ErrInvalidTodoID)
+		responses.HandleValidationError(ctx, c.logger, fmt.Errorf("%w: %w", ErrInvalidTodoID, err))
 		return
 	}
 
-	todo, err := c.service.GetByID(parsedID)
+	todo, err := c.serviceFor(ctx).GetByID(parsedID)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
-	todoResponse := TodoResponse{
-		ID:          todo.ID.String(),
-		Title:       todo.Title,
-		Description: todo.Description,
-		CreatedAt:   todo.CreatedAt,
-		UpdatedAt:   todo.UpdatedAt,
-	}
+	todoResponse := TodoToResponse(&todo)
 
 	responses.DetailResponse(
 		ctx,
@@ -121,12 +110,12 @@ func (c *Controller) UpdateTodo(ctx *gin.Context) {
 
 	parsedID, err := types.ShouldParseUUID(todoID)
 	if err != nil {
-		responses.HandleValidationError(ctx, c.logger, ErrInvalidTodoID)
+		responses.HandleValidationError(ctx, c.logger, fmt.Errorf("%w: %w", ErrInvalidTodoID, err))
 		return
 	}
 
 	// Get the existing todo first
-	todo, err := c.service.GetByID(parsedID)
+	todo, err := c.serviceFor(ctx).GetByID(parsedID)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -146,20 +135,20 @@ func (c *Controller) UpdateTodo(ctx *gin.Context) {
 	if req.Description != nil {
 		todo.Description = *req.Description
 	}
+	if req.Status != nil {
+		if err := c.serviceFor(ctx).SetStatus(&todo, *req.Status); err != nil {
+			responses.HandleValidationError(ctx, c.logger, err)
+			return
+		}
+	}
 	todo.UpdatedAt = time.Now()
 
-	if err := c.service.Update(&todo); err != nil {
+	if err := c.serviceFor(ctx).Update(&todo); err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
 
-	todoResponse := TodoResponse{
-		ID:          todo.ID.String(),
-		Title:       todo.Title,
-		Description: todo.Description,
-		CreatedAt:   todo.CreatedAt,
-		UpdatedAt:   todo.UpdatedAt,
-	}
+	todoResponse := TodoToResponse(&todo)
 
 	responses.DetailResponse(
 		ctx,
@@ -171,22 +160,36 @@ func (c *Controller) UpdateTodo(ctx *gin.Context) {
 	)
 }
 
-// FetchTodoWithPagination gets todos with pagination
-func (c *Controller) FetchTodoWithPagination(ctx *gin.Context) {
-	pageStr := ctx.DefaultQuery("page", "1")
-	limitStr := ctx.DefaultQuery("limit", "10")
-
-	page, err := strconv.Atoi(pageStr)
-	if err != nil || page < 1 {
-		page = 1
+// GetTodoStats gets the todo counts by status
+func (c *Controller) GetTodoStats(ctx *gin.Context) {
+	stats, err := c.serviceFor(ctx).Stats()
+	if err != nil {
+		responses.HandleError(ctx, c.logger, err)
+		return
 	}
 
-	limit, err := strconv.Atoi(limitStr)
-	if err != nil || limit < 1 {
-		limit = 10
+	responses.DetailResponse(
+		ctx,
+		http.StatusOK,
+		responses.DetailResponseType[TodoStats]{
+			Item:    stats,
+			Message: "success",
+		},
+	)
+}
+
+// FetchTodoWithPagination gets todos with pagination
+func (c *Controller) FetchTodoWithPagination(ctx *gin.Context) {
+	// Parse pagination parameters
+	pagination, err := utils.ParsePagination(ctx)
+	if err != nil {
+		responses.HandleValidationError(ctx, c.logger, err)
+		return
 	}
+	page, limit := pagination.Page, pagination.Limit
 
-	todos, total, err := c.service.List(page, limit)
+	status := ctx.Query("status")
+	todos, total, err := c.serviceFor(ctx).List(page, limit, status)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -196,21 +199,16 @@ func (c *Controller) FetchTodoWithPagination(ctx *gin.Context) {
 	items := make([]TodoListItem, len(todos))
 	for i, todo := range todos {
 		items[i] = TodoListItem{
-			ID:    todo.ID.String(),
-			Title: todo.Title,
+			ID:     todo.ID.String(),
+			Title:  todo.Title,
+			Status: todo.Status,
 		}
 	}
 
-	// Check if there are more items
-	hasNext := (int64(page*limit) < total)
-
 	response := TodoListResponse{
-		Items:   items,
-		Message: "success",
-		Pagination: responses.PaginationResponseType{
-			Total:   total,
-			HasNext: hasNext,
-		},
+		Items:      items,
+		Message:    "success",
+		Pagination: responses.NewPagination(page, limit, total),
 	}
 
 	responses.ListResponse(
@@ -219,3 +217,8 @@ func (c *Controller) FetchTodoWithPagination(ctx *gin.Context) {
 		response,
 	)
 }
+
+// serviceFor returns the service running its queries with the request context, which carries the request timeout
+func (c *Controller) serviceFor(ctx *gin.Context) *Service {
+	return c.service.WithContext(ctx.Request.Context())
+}
@@ -1,6 +1,7 @@
 package todo
 
 import (
+	"clean-architecture/domain/models"
 	"clean-architecture/pkg/responses"
 	"time"
 )
@@ -13,17 +14,21 @@ type CreateTodoRequest struct {
 
 // TodoResponse DTO for todo response
 type TodoResponse struct {
-	ID          string    `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	CompletedAt *time.Time `json:"completed_at"`
+	ArchivedAt  *time.Time `json:"archived_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
 // TodoListItem DTO for items in todo list
 type TodoListItem struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
 }
 
 // TodoListResponse DTO for paginated todo list
@@ -33,4 +38,34 @@ type TodoListResponse = responses.ListResponseType[TodoListItem]
 type UpdateTodoRequest struct {
 	Title       *string `json:"title"`
 	Description *string `json:"description"`
+	Status      *string `json:"status"`
+}
+
+// TodoStats DTO for todo counts
+type TodoStats struct {
+	Total     int64 `json:"total"`
+	Completed int64 `json:"completed"`
+	Pending   int64 `json:"pending"`
+	Archived  int64 `json:"archived"`
+}
+
+// TodoQueryParams for filtering todos
+type TodoQueryParams struct {
+	Status string `form:"status"`
+	Page   int    `form:"page"`
+	Limit  int    `form:"limit"`
+}
+
+// TodoToResponse converts a todo model to the response DTO
+func TodoToResponse(todo *models.Todo) TodoResponse {
+	return TodoResponse{
+		ID:          todo.ID.String(),
+		Title:       todo.Title,
+		Description: todo.Description,
+		Status:      todo.Status,
+		CompletedAt: todo.CompletedAt,
+		ArchivedAt:  todo.ArchivedAt,
+		CreatedAt:   todo.CreatedAt,
+		UpdatedAt:   todo.UpdatedAt,
+	}
 }
@@ -8,4 +8,5 @@ var (
 	ErrInvalidTodoID     = errorz.ErrBadRequest.JoinError("Invalid Todo ID")
 	ErrTodoNotFound      = errorz.ErrNotFound.JoinError("Todo not found")
 	ErrTodoTitleRequired = errorz.ErrBadRequest.JoinError("Todo title is required")
+	ErrInvalidTodoStatus = errorz.ErrBadRequest.JoinError("Invalid todo status")
 )
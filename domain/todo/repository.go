@@ -5,6 +5,9 @@ import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
 	"clean-architecture/pkg/types"
+	"context"
+
+	"gorm.io/gorm"
 )
 
 // Repository database structure
@@ -18,6 +21,14 @@ func NewRepository(db infrastructure.Database, logger framework.Logger) *Reposit
 	return &Repository{db, logger}
 }
 
+// WithContext returns a copy of the repository running its queries with the context,
+// so they are cancelled together with the request
+func (r *Repository) WithContext(ctx context.Context) Repository {
+	scoped := *r
+	scoped.Database.DB = r.DB.WithContext(ctx)
+	return scoped
+}
+
 // Create creates a new todo
 func (r *Repository) Create(todo *models.Todo) error {
 	r.logger.Info("[TodoRepository...Create]")
@@ -36,18 +47,45 @@ func (r *Repository) Update(todo *models.Todo) error {
 	return r.DB.Save(todo).Error
 }
 
-// List returns todos with pagination
-func (r *Repository) List(page, limit int) (todos []models.Todo, total int64, err error) {
+// CountByStatus counts todos grouped by status
+func (r *Repository) CountByStatus() (map[string]int64, error) {
+	r.logger.Info("[TodoRepository...CountByStatus]")
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+
+	err := r.DB.Model(&models.Todo{}).Select("status, COUNT(*) AS count").Group("status").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// List returns todos with pagination, filtered by status when not empty
+func (r *Repository) List(page, limit int, status string) (todos []models.Todo, total int64, err error) {
 	r.logger.Info("[TodoRepository...List]")
 
 	offset := (page - 1) * limit
 
+	filter := func(db *gorm.DB) *gorm.DB {
+		if status != "" {
+			return db.Where("status = ?", status)
+		}
+		return db
+	}
+
 	// Get total count
-	if err = r.DB.Model(&models.Todo{}).Count(&total).Error; err != nil {
+	if err = r.DB.Model(&models.Todo{}).Scopes(filter).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	// Get todos with pagination
-	err = r.DB.Offset(offset).Limit(limit).Find(&todos).Error
+	err = r.DB.Scopes(filter).Offset(offset).Limit(limit).Find(&todos).Error
 	return todos, total, err
 }
@@ -3,6 +3,9 @@ package todo
 import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/openapi"
+	"clean-architecture/pkg/responses"
+	"net/http"
 )
 
 // Route struct
@@ -10,6 +13,7 @@ type Route struct {
 	logger     framework.Logger
 	handler    infrastructure.Router
 	controller *Controller
+	spec       *openapi.Spec
 }
 
 // NewRoute creates a new route
@@ -17,11 +21,13 @@ func NewRoute(
 	logger framework.Logger,
 	handler infrastructure.Router,
 	controller *Controller,
+	spec *openapi.Spec,
 ) *Route {
 	return &Route{
 		handler:    handler,
 		logger:     logger,
 		controller: controller,
+		spec:       spec,
 	}
 }
 
@@ -34,6 +40,42 @@ func RegisterRoute(r *Route) {
 	// Todo routes based on the .bru files
 	api.POST("", r.controller.CreateTodo)
 	api.GET("", r.controller.FetchTodoWithPagination)
+	api.GET("/stats", r.controller.GetTodoStats)
 	api.GET("/:id", r.controller.GetTodoByID)
 	api.PUT("/:id", r.controller.UpdateTodo)
+
+	describeRoutes(r.spec)
+}
+
+// describeRoutes documents the todo routes in the openapi spec
+func describeRoutes(spec *openapi.Spec) {
+	spec.Describe(http.MethodPost, "/api/todos", openapi.Operation{
+		Summary:  "Create todo",
+		Tag:      "todo",
+		Request:  CreateTodoRequest{},
+		Response: responses.DetailResponseType[TodoResponse]{},
+		Status:   http.StatusCreated,
+	})
+	spec.Describe(http.MethodGet, "/api/todos", openapi.Operation{
+		Summary:  "List todos",
+		Tag:      "todo",
+		Query:    TodoQueryParams{},
+		Response: TodoListResponse{},
+	})
+	spec.Describe(http.MethodGet, "/api/todos/stats", openapi.Operation{
+		Summary:  "Count todos by status",
+		Tag:      "todo",
+		Response: responses.DetailResponseType[TodoStats]{},
+	})
+	spec.Describe(http.MethodGet, "/api/todos/:id", openapi.Operation{
+		Summary:  "Get todo",
+		Tag:      "todo",
+		Response: responses.DetailResponseType[TodoResponse]{},
+	})
+	spec.Describe(http.MethodPut, "/api/todos/:id", openapi.Operation{
+		Summary:  "Update todo",
+		Tag:      "todo",
+		Request:  UpdateTodoRequest{},
+		Response: responses.DetailResponseType[TodoResponse]{},
+	})
 }
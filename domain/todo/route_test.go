@@ -2,14 +2,20 @@ package todo_test
 
 import (
 	"clean-architecture/domain/todo"
+	"clean-architecture/pkg/errorz"
+	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/middlewares"
 	"clean-architecture/pkg/responses"
 	"clean-architecture/testutil"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/steinfletcher/apitest"
@@ -18,9 +24,11 @@ import (
 
 var _ = Describe("Domain/Todo/Route", Ordered, func() {
 	var (
-		router      infrastructure.Router
-		todoService *todo.Service
-		todoRepo    *todo.Repository
+		router         infrastructure.Router
+		todoService    *todo.Service
+		todoRepo       *todo.Repository
+		todoController *todo.Controller
+		logger         framework.Logger
 	)
 
 	BeforeAll(func() {
@@ -29,6 +37,8 @@ var _ = Describe("Domain/Todo/Route", Ordered, func() {
 				fx.Populate(&router),
 				fx.Populate(&todoService),
 				fx.Populate(&todoRepo),
+				fx.Populate(&todoController),
+				fx.Populate(&logger),
 			)
 			if err != nil {
 				t.Error(err)
@@ -57,13 +67,36 @@ var _ = Describe("Domain/Todo/Route", Ordered, func() {
 		return responseBody.Item.ID, nil
 	}
 
+	It("should time out list and lookup queries once the request deadline passes", func() {
+		timedOut := infrastructure.Router{Engine: gin.New()}
+		middlewares.NewTimeoutMiddleware(logger, timedOut, &framework.Env{
+			RequestTimeout: time.Nanosecond,
+		}).Setup()
+		timedOut.GET("/api/todos", todoController.FetchTodoWithPagination)
+		timedOut.GET("/api/todos/:id", todoController.GetTodoByID)
+
+		for _, path := range []string{"/api/todos", "/api/todos/" + uuid.NewString()} {
+			apitest.
+				New().
+				Handler(timedOut).
+				Get(path).
+				Expect(t).
+				Status(http.StatusGatewayTimeout).
+				Body(fmt.Sprintf(`{"error": %q}`, errorz.ErrRequestTimeout.Error())).
+				End()
+		}
+	})
+
 	It("should return empty list of todos", func() {
 		expected := todo.TodoListResponse{
 			Message: "success",
 			Items:   []todo.TodoListItem{},
 			Pagination: responses.PaginationResponseType{
-				Total:   0,
-				HasNext: false,
+				Total:       0,
+				HasNext:     false,
+				CurrentPage: 1,
+				PerPage:     10,
+				LastPage:    1,
 			},
 		}
 		expectedJSON, _ := json.MarshalIndent(expected, "", "  ")
@@ -179,6 +212,11 @@ var _ = Describe("Domain/Todo/Route", Ordered, func() {
 
 		response := result.Response
 		Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+		var responseBody map[string]string
+		Expect(json.NewDecoder(response.Body).Decode(&responseBody)).To(Succeed())
+		Expect(responseBody["error"]).To(ContainSubstring(todo.ErrInvalidTodoID.Error()))
+		Expect(responseBody["error"]).To(ContainSubstring(invalidID))
 	})
 
 	It("should update a todo", func() {
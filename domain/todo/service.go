@@ -1,10 +1,14 @@
 package todo
 
 import (
+	"clean-architecture/domain/constants"
 	"clean-architecture/domain/models"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/types"
+	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -26,8 +30,21 @@ func NewService(
 	}
 }
 
-// Create creates a new todo
+// WithContext returns a copy of the service running its queries with the context,
+// so they are cancelled together with the request
+func (s Service) WithContext(ctx context.Context) *Service {
+	s.repository = s.repository.WithContext(ctx)
+	return &s
+}
+
+// Create creates a new todo, todos are open unless a status is given
 func (s Service) Create(todo *models.Todo) error {
+	if todo.Status == "" {
+		todo.Status = constants.TodoStatusOpen
+	}
+	if !isValidStatus(todo.Status) {
+		return ErrInvalidTodoStatus
+	}
 	return s.repository.Create(todo)
 }
 
@@ -48,7 +65,57 @@ func (s Service) Update(todo *models.Todo) error {
 	return s.repository.Update(todo)
 }
 
-// List returns todos with pagination
-func (s Service) List(page, limit int) ([]models.Todo, int64, error) {
-	return s.repository.List(page, limit)
+// SetStatus moves a todo to a status and stamps the matching timestamp, the todo is not saved
+// done stamps CompletedAt, archived stamps ArchivedAt and reopening clears both
+func (s Service) SetStatus(todo *models.Todo, status string) error {
+	if !isValidStatus(status) {
+		return ErrInvalidTodoStatus
+	}
+	if todo.Status == status {
+		return nil
+	}
+
+	now := time.Now()
+	switch status {
+	case constants.TodoStatusOpen:
+		todo.CompletedAt = nil
+		todo.ArchivedAt = nil
+	case constants.TodoStatusDone:
+		todo.CompletedAt = &now
+		todo.ArchivedAt = nil
+	case constants.TodoStatusArchived:
+		todo.ArchivedAt = &now
+	}
+	todo.Status = status
+	return nil
+}
+
+// List returns todos with pagination, filtered by status when not empty
+func (s Service) List(page, limit int, status string) ([]models.Todo, int64, error) {
+	if status != "" && !isValidStatus(status) {
+		return nil, 0, ErrInvalidTodoStatus
+	}
+	return s.repository.List(page, limit, status)
+}
+
+// Stats counts todos by status
+func (s Service) Stats() (TodoStats, error) {
+	counts, err := s.repository.CountByStatus()
+	if err != nil {
+		return TodoStats{}, err
+	}
+
+	stats := TodoStats{
+		Completed: counts[constants.TodoStatusDone],
+		Pending:   counts[constants.TodoStatusOpen],
+		Archived:  counts[constants.TodoStatusArchived],
+	}
+	for _, count := range counts {
+		stats.Total += count
+	}
+	return stats, nil
+}
+
+func isValidStatus(status string) bool {
+	return slices.Contains(constants.TodoStatuses, status)
 }
@@ -1,6 +1,7 @@
 package todo_test
 
 import (
+	"clean-architecture/domain/constants"
 	"clean-architecture/domain/models"
 	"clean-architecture/domain/todo"
 	"clean-architecture/pkg/infrastructure"
@@ -21,6 +22,7 @@ var _ = Describe("Domain/Todo/Service", Ordered, func() {
 		router      infrastructure.Router
 		todoService *todo.Service
 		todoRepo    *todo.Repository
+		db          infrastructure.Database
 	)
 
 	BeforeAll(func() {
@@ -29,6 +31,7 @@ var _ = Describe("Domain/Todo/Service", Ordered, func() {
 				fx.Populate(&router),
 				fx.Populate(&todoService),
 				fx.Populate(&todoRepo),
+				fx.Populate(&db),
 			)
 			if err != nil {
 				t.Error(err)
@@ -139,39 +142,159 @@ var _ = Describe("Domain/Todo/Service", Ordered, func() {
 		Expect(updatedTodo.Description).To(Equal(originalDescription))
 	})
 
-	It("should list todos with pagination", func() {
-		// Arrange - Create multiple todos
-		for i := 1; i <= 15; i++ {
-			_, err := createTestTodo(
-				"Pagination Todo "+GinkgoT().Name(),
-				"Description for pagination test",
-			)
-			Expect(err).To(BeNil())
-		}
+	Context("with an empty todos table", func() {
+		BeforeEach(func() {
+			Expect(testutil.TruncateTables(db.DB, &models.Todo{})).To(Succeed())
+		})
 
-		// Act - Get first page with 10 items
-		todos, total, err := todoService.List(1, 10)
+		It("should start with no todos", func() {
+			var count int64
+			Expect(db.Model(&models.Todo{}).Count(&count).Error).To(BeNil())
+			Expect(count).To(BeZero())
+		})
 
-		// Assert
-		Expect(err).To(BeNil())
-		Expect(len(todos)).To(Equal(10))
-		Expect(total).To(BeNumerically(">=", 15))
+		It("should list todos with pagination", func() {
+			// Arrange - Create multiple todos
+			for i := 1; i <= 15; i++ {
+				_, err := createTestTodo(
+					"Pagination Todo "+GinkgoT().Name(),
+					"Description for pagination test",
+				)
+				Expect(err).To(BeNil())
+			}
 
-		// Act - Get second page with remaining items
-		todosPage2, totalPage2, err := todoService.List(2, 10)
+			// Act - Get first page with 10 items
+			todos, total, err := todoService.List(1, 10, "")
 
-		// Assert
-		Expect(err).To(BeNil())
-		Expect(len(todosPage2)).To(BeNumerically(">=", 5))
-		Expect(totalPage2).To(Equal(total))
+			// Assert
+			Expect(err).To(BeNil())
+			Expect(len(todos)).To(Equal(10))
+			Expect(total).To(Equal(int64(15)))
+
+			// Act - Get second page with remaining items
+			todosPage2, totalPage2, err := todoService.List(2, 10, "")
+
+			// Assert
+			Expect(err).To(BeNil())
+			Expect(len(todosPage2)).To(Equal(5))
+			Expect(totalPage2).To(Equal(total))
+		})
 	})
 
 	It("should handle custom pagination limits", func() {
 		// Act
-		todos, _, err := todoService.List(1, 5)
+		todos, _, err := todoService.List(1, 5, "")
 
 		// Assert
 		Expect(err).To(BeNil())
 		Expect(len(todos)).To(BeNumerically("<=", 5))
 	})
+
+	Context("status transitions", func() {
+		It("should create todos as open", func() {
+			createdTodo, err := createTestTodo("Open Todo", "Starts open")
+			Expect(err).To(BeNil())
+			Expect(createdTodo.Status).To(Equal(constants.TodoStatusOpen))
+			Expect(createdTodo.CompletedAt).To(BeNil())
+			Expect(createdTodo.ArchivedAt).To(BeNil())
+		})
+
+		It("should stamp completed_at when done and archived_at when archived", func() {
+			createdTodo, err := createTestTodo("Transition Todo", "Moves through every status")
+			Expect(err).To(BeNil())
+
+			Expect(todoService.SetStatus(createdTodo, constants.TodoStatusDone)).To(Succeed())
+			Expect(todoService.Update(createdTodo)).To(Succeed())
+
+			doneTodo, err := todoService.GetByID(createdTodo.ID)
+			Expect(err).To(BeNil())
+			Expect(doneTodo.Status).To(Equal(constants.TodoStatusDone))
+			Expect(doneTodo.CompletedAt).NotTo(BeNil())
+			Expect(doneTodo.ArchivedAt).To(BeNil())
+
+			Expect(todoService.SetStatus(&doneTodo, constants.TodoStatusArchived)).To(Succeed())
+			Expect(todoService.Update(&doneTodo)).To(Succeed())
+
+			archivedTodo, err := todoService.GetByID(createdTodo.ID)
+			Expect(err).To(BeNil())
+			Expect(archivedTodo.Status).To(Equal(constants.TodoStatusArchived))
+			Expect(archivedTodo.ArchivedAt).NotTo(BeNil())
+			Expect(archivedTodo.CompletedAt).NotTo(BeNil())
+		})
+
+		It("should clear the timestamps when reopened", func() {
+			createdTodo, err := createTestTodo("Reopened Todo", "Done then open again")
+			Expect(err).To(BeNil())
+
+			Expect(todoService.SetStatus(createdTodo, constants.TodoStatusDone)).To(Succeed())
+			Expect(todoService.SetStatus(createdTodo, constants.TodoStatusOpen)).To(Succeed())
+			Expect(todoService.Update(createdTodo)).To(Succeed())
+
+			reopenedTodo, err := todoService.GetByID(createdTodo.ID)
+			Expect(err).To(BeNil())
+			Expect(reopenedTodo.Status).To(Equal(constants.TodoStatusOpen))
+			Expect(reopenedTodo.CompletedAt).To(BeNil())
+			Expect(reopenedTodo.ArchivedAt).To(BeNil())
+		})
+
+		It("should reject an unknown status", func() {
+			createdTodo, err := createTestTodo("Invalid Status Todo", "Keeps its status")
+			Expect(err).To(BeNil())
+
+			Expect(todoService.SetStatus(createdTodo, "finished")).To(MatchError(todo.ErrInvalidTodoStatus))
+			Expect(createdTodo.Status).To(Equal(constants.TodoStatusOpen))
+		})
+
+		It("should filter todos by status", func() {
+			Expect(testutil.TruncateTables(db.DB, &models.Todo{})).To(Succeed())
+			for _, title := range []string{"Filter Open", "Filter Done One", "Filter Done Two"} {
+				_, err := createTestTodo(title, "")
+				Expect(err).To(BeNil())
+			}
+			doneTodos, _, err := todoService.List(1, 10, "")
+			Expect(err).To(BeNil())
+			for i := range doneTodos {
+				if doneTodos[i].Title != "Filter Open" {
+					Expect(todoService.SetStatus(&doneTodos[i], constants.TodoStatusDone)).To(Succeed())
+					Expect(todoService.Update(&doneTodos[i])).To(Succeed())
+				}
+			}
+
+			todos, total, err := todoService.List(1, 10, constants.TodoStatusDone)
+			Expect(err).To(BeNil())
+			Expect(total).To(Equal(int64(2)))
+			Expect(todos).To(HaveLen(2))
+
+			_, _, err = todoService.List(1, 10, "finished")
+			Expect(err).To(MatchError(todo.ErrInvalidTodoStatus))
+		})
+	})
+
+	It("should count todos by status", func() {
+		Expect(testutil.TruncateTables(db.DB, &models.Todo{})).To(Succeed())
+
+		statuses := []string{
+			constants.TodoStatusOpen,
+			constants.TodoStatusOpen,
+			constants.TodoStatusOpen,
+			constants.TodoStatusDone,
+			constants.TodoStatusDone,
+			constants.TodoStatusArchived,
+		}
+		for _, status := range statuses {
+			createdTodo, err := createTestTodo("Stats Todo", "")
+			Expect(err).To(BeNil())
+			Expect(todoService.SetStatus(createdTodo, status)).To(Succeed())
+			Expect(todoService.Update(createdTodo)).To(Succeed())
+		}
+
+		stats, err := todoService.Stats()
+		Expect(err).To(BeNil())
+		Expect(stats).To(Equal(todo.TodoStats{
+			Total:     6,
+			Completed: 2,
+			Pending:   3,
+			Archived:  1,
+		}))
+	})
 })
@@ -5,6 +5,7 @@ import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/responses"
 	"clean-architecture/pkg/types"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -45,7 +46,7 @@ func (c *Controller) CreateUser(ctx *gin.Context) {
 	}
 
 	// check if the user already exists
-	if err := c.service.Create(&user); err != nil {
+	if err := c.serviceFor(ctx).Create(&user); err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
 	}
@@ -59,11 +60,11 @@ func (c *Controller) GetUserByID(ctx *gin.Context) {
 
 	userID, err := types.ShouldParseUUID(paramID)
 	if err != nil {
-		responses.HandleValidationError(ctx, c.logger, ErrInvalidUserID)
+		responses.HandleValidationError(ctx, c.logger, fmt.Errorf("%w%w", ErrInvalidUserID, err))
 		return
 	}
 
-	user, err := c.service.GetUserByID(userID)
+	user, err := c.serviceFor(ctx).GetUserByID(userID)
 	if err != nil {
 		responses.HandleError(ctx, c.logger, err)
 		return
@@ -78,3 +79,8 @@ func (c *Controller) GetUserByID(ctx *gin.Context) {
 		},
 	)
 }
+
+// serviceFor returns the service running its queries with the request context, which carries the request timeout
+func (c *Controller) serviceFor(ctx *gin.Context) *Service {
+	return c.service.WithContext(ctx.Request.Context())
+}
@@ -4,6 +4,7 @@ import (
 	"clean-architecture/domain/models"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
+	"context"
 )
 
 // UserRepository database structure
@@ -17,6 +18,19 @@ func NewRepository(db infrastructure.Database, logger framework.Logger) Reposito
 	return Repository{db, logger}
 }
 
+// WithContext returns a copy of the repository running its queries with the context
+func (r *Repository) WithContext(ctx context.Context) Repository {
+	scoped := *r
+	scoped.Database.DB = r.DB.WithContext(ctx)
+	return scoped
+}
+
+// GetUserByCognitoUID gets the user signed up with the cognito uid
+func (r *Repository) GetUserByCognitoUID(cognitoUID string) (user models.User, err error) {
+	r.logger.Info("[UserRepository...GetUserByCognitoUID]")
+	return user, r.DB.Where("cognito_uid = ?", cognitoUID).First(&user).Error
+}
+
 // ExistsByEmail checks if the user exists by email
 func (r *Repository) ExistsByEmail(email string) (bool, error) {
 	r.logger.Info("[UserRepository...Exists]")
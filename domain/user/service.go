@@ -1,11 +1,28 @@
 package user
 
 import (
+	"clean-architecture/domain/constants"
 	"clean-architecture/domain/models"
+	"clean-architecture/pkg/errorz"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/types"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
 )
 
+// Identity is the signed in user behind a request
+type Identity struct {
+	UserID  types.BinaryUUID
+	IsAdmin bool
+}
+
+// IsAuthenticated checks if the identity belongs to a signed in user
+func (i Identity) IsAuthenticated() bool {
+	return i.UserID != types.BinaryUUID{}
+}
+
 // UserService service layer
 type Service struct {
 	logger     framework.Logger
@@ -23,6 +40,12 @@ func NewService(
 	}
 }
 
+// WithContext returns a copy of the service running its queries with the context
+func (s Service) WithContext(ctx context.Context) *Service {
+	s.repository = s.repository.WithContext(ctx)
+	return &s
+}
+
 // Create creates the user in database
 func (s Service) Create(user *models.User) error {
 	return s.repository.Create(user).Error
@@ -33,6 +56,27 @@ func (s Service) GetUserByID(userID types.BinaryUUID) (user models.User, err err
 	return user, s.repository.First(&user, "id = ?", userID).Error
 }
 
+// GetIdentity resolves the user signed in with the cognito uid, set in the context by the cognito auth middleware
+// an empty uid gives an anonymous identity, and only users with the admin role are admins
+func (s Service) GetIdentity(cognitoUID string) (Identity, error) {
+	if cognitoUID == "" {
+		return Identity{}, nil
+	}
+
+	user, err := s.repository.GetUserByCognitoUID(cognitoUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Identity{}, errorz.ErrUnauthorizedAccess
+		}
+		return Identity{}, err
+	}
+
+	return Identity{
+		UserID:  user.UUID,
+		IsAdmin: user.Role == constants.UserRoleAdmin,
+	}, nil
+}
+
 // GetRawUserFromID gets the raw user from id
 func (r *Repository) GetRawUserFromID(userID uint) (user *models.User, err error) {
 	r.logger.Info("[UserRepository...GetRawUserFromID]")
@@ -0,0 +1,2 @@
+-- Modify "resources" table
+ALTER TABLE `resources` ADD COLUMN `buffer_minutes` bigint NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Modify "resources" table
+ALTER TABLE `resources` ADD COLUMN `under_maintenance` bool NULL DEFAULT 0;
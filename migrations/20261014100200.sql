@@ -0,0 +1,2 @@
+-- Modify "bookings" table
+ALTER TABLE `bookings` ADD COLUMN `checked_in_at` datetime(3) NULL, ADD COLUMN `checked_out_at` datetime(3) NULL;
@@ -0,0 +1,4 @@
+-- Backfill missing booking references
+UPDATE `bookings` SET `reference` = CONCAT('BK-', UPPER(HEX(`uuid`))) WHERE `reference` IS NULL OR `reference` = '';
+-- Modify "bookings" table
+ALTER TABLE `bookings` ADD UNIQUE INDEX `idx_bookings_reference` (`reference`);
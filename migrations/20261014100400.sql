@@ -0,0 +1,2 @@
+-- Modify "todos" table
+ALTER TABLE `todos` ADD COLUMN `status` varchar(20) NULL DEFAULT 'open', ADD COLUMN `completed_at` datetime(3) NULL, ADD COLUMN `archived_at` datetime(3) NULL, ADD INDEX `idx_todos_status` (`status`);
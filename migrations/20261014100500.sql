@@ -0,0 +1,2 @@
+-- Modify "bookings" table
+ALTER TABLE `bookings` ADD COLUMN `seats` bigint NULL DEFAULT 1;
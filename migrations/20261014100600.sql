@@ -0,0 +1,2 @@
+-- Modify "resources" table
+ALTER TABLE `resources` ADD COLUMN `seat_based` bool NULL DEFAULT 0;
@@ -14,6 +14,7 @@ var (
 	ErrUnprocessable      = NewAPIError(http.StatusUnprocessableEntity, "Unable to process the contained instructions")
 	ErrInternal           = NewAPIError(http.StatusInternalServerError, "Internal Server Error")
 	ErrServiceUnavailable = NewAPIError(http.StatusServiceUnavailable, "Service Unavailable")
+	ErrGatewayTimeout     = NewAPIError(http.StatusGatewayTimeout, "Gateway Timeout")
 	ErrAlreadyExists      = JoinError("Already Exists", ErrConflict)
 	ErrSomethingWentWrong = JoinError("something went wrong", ErrInternal)
 )
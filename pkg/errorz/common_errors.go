@@ -10,4 +10,6 @@ var (
 	ErrExtensionMismatch         = ErrBadRequest.JoinError("file extension not supported")
 	ErrThumbExtensionMismatch    = ErrBadRequest.JoinError("file extension not supported for thumbnail")
 	ErrFileRead                  = ErrBadRequest.JoinError("file read error")
+	ErrInvalidPagination         = ErrBadRequest.JoinError("Invalid pagination")
+	ErrRequestTimeout            = ErrGatewayTimeout.JoinError("Request timed out")
 )
@@ -1,13 +1,19 @@
 package framework
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 type Env struct {
 	LogLevel    string `mapstructure:"LOG_LEVEL"`
+	LogFormat   string `mapstructure:"LOG_FORMAT"`
 	ServerPort  string `mapstructure:"SERVER_PORT"`
 	Environment string `mapstructure:"ENVIRONMENT"`
+	SeedEnabled bool   `mapstructure:"SEED_ENABLED"`
+
+	RequestTimeout time.Duration `mapstructure:"REQUEST_TIMEOUT"`
 
 	DBUsername string `mapstructure:"DB_USER"`
 	DBPassword string `mapstructure:"DB_PASS"`
@@ -16,6 +22,8 @@ type Env struct {
 	DBName     string `mapstructure:"DB_NAME"`
 	DBType     string `mapstructure:"DB_TYPE"`
 
+	TestDBEngine string `mapstructure:"TEST_DB_ENGINE"`
+
 	SentryDSN          string `mapstructure:"SENTRY_DSN"`
 	MaxMultipartMemory int64  `mapstructure:"MAX_MULTIPART_MEMORY"`
 	StorageBucketName  string `mapstructure:"STORAGE_BUCKET_NAME"`
@@ -49,6 +57,7 @@ func NewEnv(logger Logger) *Env {
 	}
 
 	viper.SetDefault("TIMEZONE", "UTC")
+	viper.SetDefault("REQUEST_TIMEOUT", "30s")
 
 	err = viper.Unmarshal(&globalEnv)
 	if err != nil {
@@ -53,10 +53,28 @@ func newLogger() *Logger {
 
 	env := os.Getenv("ENVIRONMENT")
 	logLevel := os.Getenv("LOG_LEVEL")
+	logFormat := os.Getenv("LOG_FORMAT")
 
+	config := newZapConfig(env, logLevel, logFormat)
+	zapLogger, _ = config.Build()
+
+	globalLog := zapLogger.Sugar()
+
+	return &Logger{
+		SugaredLogger: globalLog,
+	}
+
+}
+
+// newZapConfig builds zap config from environment, log level and log format (console/json)
+func newZapConfig(env, logLevel, logFormat string) zap.Config {
 	config := zap.NewDevelopmentConfig()
 
-	if env == "local" {
+	if logFormat == "json" {
+		config.Encoding = "json"
+		config.EncoderConfig = zap.NewProductionEncoderConfig()
+		config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	} else if env == "local" {
 		config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	}
 
@@ -76,14 +94,8 @@ func newLogger() *Logger {
 		level = zap.PanicLevel
 	}
 	config.Level.SetLevel(level)
-	zapLogger, _ = config.Build()
-
-	globalLog := zapLogger.Sugar()
-
-	return &Logger{
-		SugaredLogger: globalLog,
-	}
 
+	return config
 }
 
 func newSugaredLogger(logger *zap.Logger) *Logger {
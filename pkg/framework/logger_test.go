@@ -0,0 +1,41 @@
+package framework
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewZapConfigJSONFormat(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "app.log")
+
+	config := newZapConfig("production", "info", "json")
+	config.OutputPaths = []string{logFile}
+
+	logger, err := config.Build()
+	assert.NoError(t, err)
+
+	logger.Sugar().Info("booking created")
+	_ = logger.Sync()
+
+	file, err := os.Open(logFile)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	assert.True(t, scanner.Scan(), "expected a log line")
+
+	var line map[string]any
+	assert.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+	assert.Equal(t, "booking created", line["msg"])
+	assert.Equal(t, "info", line["level"])
+}
+
+func TestNewZapConfigConsoleFormat(t *testing.T) {
+	assert.Equal(t, "console", newZapConfig("local", "info", "").Encoding)
+	assert.Equal(t, "console", newZapConfig("local", "info", "console").Encoding)
+}
@@ -84,26 +84,22 @@ func NewMockDB() Database {
 	return Database{DB: db}
 }
 
+// atlasExecPath is the atlas cli binary used for running migrations
+var atlasExecPath = "atlas"
+
+// atlasDevURL is the dev database atlas uses to compute the down migrations
+const atlasDevURL = "docker://mysql/8/dev"
+
 func (d *Database) RunMigration() {
-	workdir, err := atlasexec.NewWorkingDir(
-		atlasexec.WithMigrations(
-			os.DirFS("./migrations"),
-		),
-	)
+	workdir, client, err := d.newAtlasClient()
 	if err != nil {
-		d.Logger.Fatalf("failed to load working directory: %v", err)
+		d.Logger.Fatalf("failed to initialize atlas: %v", err)
 	}
 	// atlasexec works on a temporary directory, so we need to close it
 	defer workdir.Close()
 
-	// Initialize the client.
-	client, err := atlasexec.NewClient(workdir.Path(), "atlas")
-	if err != nil {
-		d.Logger.Fatalf("failed to initialize client: %v", err)
-	}
-
 	res, err := client.MigrateApply(context.Background(), &atlasexec.MigrateApplyParams{
-		URL:       fmt.Sprintf("mysql://%s:%s@%s:%s/%s?charset=utf8mb4&parseTime=True&loc=Local", d.Env.DBUsername, d.Env.DBPassword, d.Env.DBHost, d.Env.DBPort, d.Env.DBName),
+		URL:       d.migrationURL(),
 		ExecOrder: "non-linear",
 	})
 
@@ -113,3 +109,60 @@ func (d *Database) RunMigration() {
 
 	d.Logger.Infof("Applied %d migrations\n", len(res.Applied))
 }
+
+// RollbackMigration reverts the last given number of applied migrations
+func (d *Database) RollbackMigration(steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("rollback steps must be greater than zero, got %d", steps)
+	}
+
+	workdir, client, err := d.newAtlasClient()
+	if err != nil {
+		return err
+	}
+	// atlasexec works on a temporary directory, so we need to close it
+	defer workdir.Close()
+
+	res, err := client.MigrateDown(context.Background(), &atlasexec.MigrateDownParams{
+		URL:    d.migrationURL(),
+		DevURL: atlasDevURL,
+		Amount: uint64(steps),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rollback migrations: %w", err)
+	}
+	if res.Error != "" {
+		return fmt.Errorf("failed to rollback migrations: %s", res.Error)
+	}
+
+	for _, file := range res.Reverted {
+		d.Logger.Infof("Reverted migration %s", file.Version)
+	}
+	d.Logger.Infof("Reverted %d migrations, current version: %s\n", len(res.Reverted), res.Current)
+
+	return nil
+}
+
+// newAtlasClient loads the migrations into a working directory and creates an atlas client on it
+func (d *Database) newAtlasClient() (*atlasexec.WorkingDir, *atlasexec.Client, error) {
+	workdir, err := atlasexec.NewWorkingDir(
+		atlasexec.WithMigrations(
+			os.DirFS("./migrations"),
+		),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load working directory: %w", err)
+	}
+
+	client, err := atlasexec.NewClient(workdir.Path(), atlasExecPath)
+	if err != nil {
+		_ = workdir.Close()
+		return nil, nil, fmt.Errorf("failed to initialize client: %w", err)
+	}
+
+	return workdir, client, nil
+}
+
+func (d *Database) migrationURL() string {
+	return fmt.Sprintf("mysql://%s:%s@%s:%s/%s?charset=utf8mb4&parseTime=True&loc=Local", d.Env.DBUsername, d.Env.DBPassword, d.Env.DBHost, d.Env.DBPort, d.Env.DBName)
+}
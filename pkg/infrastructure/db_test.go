@@ -0,0 +1,73 @@
+package infrastructure
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/utils"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeAtlas writes a script standing in for the atlas cli, which records
+// the arguments it was invoked with and prints the given output
+func fakeAtlas(t *testing.T, output string) (execPath, argsFile string) {
+	dir := t.TempDir()
+	execPath = filepath.Join(dir, "atlas")
+	argsFile = filepath.Join(dir, "args")
+
+	script := "#!/bin/sh\n" +
+		"echo \"$@\" > " + argsFile + "\n" +
+		"echo '" + output + "'\n"
+	if err := os.WriteFile(execPath, []byte(script), 0o755); err != nil { //nolint:gosec // test script must be executable
+		t.Fatal(err)
+	}
+	return execPath, argsFile
+}
+
+func TestRollbackMigration(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake atlas cli requires a posix shell")
+	}
+	utils.ChDir()
+
+	execPath, argsFile := fakeAtlas(t, `{"Reverted":[{"Version":"20250514114710"}],"Current":"20240606114654","Target":"20240606114654","Total":1}`)
+	originalExecPath := atlasExecPath
+	atlasExecPath = execPath
+	defer func() {
+		atlasExecPath = originalExecPath
+	}()
+
+	db := Database{
+		Logger: framework.CreateTestLogger(t),
+		Env: &framework.Env{
+			DBUsername: "root",
+			DBPassword: "secret",
+			DBHost:     "localhost",
+			DBPort:     "3306",
+			DBName:     "clean_gin",
+		},
+	}
+
+	err := db.RollbackMigration(2)
+	assert.NoError(t, err)
+
+	args, err := os.ReadFile(argsFile)
+	assert.NoError(t, err)
+
+	invoked := strings.Fields(string(args))
+	assert.Equal(t, []string{"migrate", "down"}, invoked[:2])
+	assert.Contains(t, string(args), "--url "+db.migrationURL())
+	assert.Contains(t, string(args), "--dev-url "+atlasDevURL)
+	assert.Equal(t, "2", invoked[len(invoked)-1])
+}
+
+func TestRollbackMigrationInvalidSteps(t *testing.T) {
+	db := Database{Logger: framework.CreateTestLogger(t)}
+
+	assert.Error(t, db.RollbackMigration(0))
+	assert.Error(t, db.RollbackMigration(-1))
+}
@@ -0,0 +1,81 @@
+package middlewares
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// unmatchedRoute labels requests that did not match any route to keep the label cardinality bounded
+const unmatchedRoute = "unmatched"
+
+type MetricsMiddleware struct {
+	logger   framework.Logger
+	router   infrastructure.Router
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewMetricsMiddleware(
+	logger framework.Logger,
+	router infrastructure.Router,
+) MetricsMiddleware {
+	labels := []string{"method", "route", "status"}
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Number of HTTP requests by method, route and status.",
+	}, labels)
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by method, route and status.",
+		Buckets: prometheus.DefBuckets,
+	}, labels)
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		requests,
+		duration,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return MetricsMiddleware{
+		logger:   logger,
+		router:   router,
+		registry: registry,
+		requests: requests,
+		duration: duration,
+	}
+}
+
+// Setup records every request and exposes the metrics at /metrics
+func (mm MetricsMiddleware) Setup() {
+	mm.logger.Info("Setting up metrics middleware")
+	mm.router.Use(mm.Handle())
+	mm.router.GET("/metrics", gin.WrapH(promhttp.HandlerFor(mm.registry, promhttp.HandlerOpts{})))
+}
+
+// Handle records the count and latency of a request
+// the route template is used instead of the raw path e.g. /api/todos/:id
+func (mm MetricsMiddleware) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		status := strconv.Itoa(ctx.Writer.Status())
+
+		mm.requests.WithLabelValues(ctx.Request.Method, route, status).Inc()
+		mm.duration.WithLabelValues(ctx.Request.Method, route, status).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,70 @@
+package middlewares_test
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/middlewares"
+	"clean-architecture/pkg/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := infrastructure.Router{Engine: gin.New()}
+	middlewares.NewMetricsMiddleware(framework.CreateTestLogger(t), router).Setup()
+
+	router.GET("/todos/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	for _, id := range []string{"1", "2"} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/todos/"+id, nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	body := w.Body.String()
+	assert.Contains(t, body, `http_requests_total{method="GET",route="/todos/:id",status="200"} 2`)
+	assert.Contains(t, body, `http_request_duration_seconds_count{method="GET",route="/todos/:id",status="200"} 2`)
+	assert.NotContains(t, body, `route="/todos/1"`)
+}
+
+func TestMetricsMiddlewareRecordsPanics(t *testing.T) {
+	originalService := utils.CurrentSentryService
+	utils.CurrentSentryService = &mockSentryService{}
+	defer func() {
+		utils.CurrentSentryService = originalService
+	}()
+
+	gin.SetMode(gin.TestMode)
+	router := infrastructure.Router{Engine: gin.New()}
+	logger := framework.CreateTestLogger(t)
+	middlewares.NewMiddlewares(
+		middlewares.NewRecoveryMiddleware(logger, router),
+		middlewares.NewMetricsMiddleware(logger, router),
+		middlewares.NewTimeoutMiddleware(logger, router, &framework.Env{RequestTimeout: time.Second}),
+	).Setup()
+
+	router.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `http_requests_total{method="GET",route="/panic",status="500"} 1`)
+}
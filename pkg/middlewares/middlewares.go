@@ -7,9 +7,14 @@ var Module = fx.Options(
 	fx.Provide(
 		NewUploadMiddleware,
 		NewRateLimitMiddleware,
+		NewTimeoutMiddleware,
+		NewRecoveryMiddleware,
+		NewMetricsMiddleware,
 		NewMiddlewares,
 		NewCognitoAuthMiddleware,
 	),
+	// global middlewares must be applied before the domain routes are registered
+	fx.Invoke(func(m Middlewares) { m.Setup() }),
 )
 
 // IMiddleware middleware interface
@@ -22,8 +27,17 @@ type Middlewares []IMiddleware
 
 // NewMiddlewares creates new middlewares
 // Register the middleware that should be applied directly (globally)
-func NewMiddlewares() Middlewares {
-	return Middlewares{}
+// metrics wrap the recovery so that requests recovered from a panic are recorded with their 500
+func NewMiddlewares(
+	recoveryMiddleware RecoveryMiddleware,
+	metricsMiddleware MetricsMiddleware,
+	timeoutMiddleware TimeoutMiddleware,
+) Middlewares {
+	return Middlewares{
+		metricsMiddleware,
+		recoveryMiddleware,
+		timeoutMiddleware,
+	}
 }
 
 // Setup sets up middlewares
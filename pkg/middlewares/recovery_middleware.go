@@ -0,0 +1,61 @@
+package middlewares
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/responses"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RecoveryMiddleware struct {
+	logger framework.Logger
+	router infrastructure.Router
+}
+
+func NewRecoveryMiddleware(
+	logger framework.Logger,
+	router infrastructure.Router,
+) RecoveryMiddleware {
+	return RecoveryMiddleware{
+		logger: logger,
+		router: router,
+	}
+}
+
+// Setup applies the recovery to every request
+func (rm RecoveryMiddleware) Setup() {
+	rm.logger.Info("Setting up recovery middleware")
+	rm.router.Use(rm.Handle())
+}
+
+// Handle recovers from panics in the handlers, logs the stack
+// and responds with the standard error envelope which also reports it to sentry
+func (rm RecoveryMiddleware) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			err, ok := recovered.(error)
+			if !ok {
+				err = fmt.Errorf("%v", recovered)
+			}
+			err = fmt.Errorf("panic recovered: %w", err)
+			rm.logger.Errorf("%s\n%s", err, debug.Stack())
+
+			if ctx.Writer.Written() {
+				ctx.Abort()
+				return
+			}
+			responses.HandleError(ctx, rm.logger, err)
+			ctx.Abort()
+		}()
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,49 @@
+package middlewares_test
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/middlewares"
+	"clean-architecture/pkg/utils"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSentryService struct {
+	captured []error
+}
+
+func (m *mockSentryService) CaptureException(err error) {
+	m.captured = append(m.captured, err)
+}
+
+func TestRecoveryMiddleware(t *testing.T) {
+	mockService := &mockSentryService{}
+	originalService := utils.CurrentSentryService
+	utils.CurrentSentryService = mockService
+	defer func() {
+		utils.CurrentSentryService = originalService
+	}()
+
+	gin.SetMode(gin.TestMode)
+	router := infrastructure.Router{Engine: gin.New()}
+	middlewares.NewRecoveryMiddleware(framework.CreateTestLogger(t), router).Setup()
+
+	router.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/panic", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"error":"An error occurred while processing your request. Please try again later."}`, w.Body.String())
+
+	require.Len(t, mockService.captured, 1)
+	assert.Contains(t, mockService.captured[0].Error(), "boom")
+}
@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"clean-architecture/pkg/errorz"
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/responses"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TimeoutMiddleware struct {
+	logger  framework.Logger
+	router  infrastructure.Router
+	timeout time.Duration
+}
+
+func NewTimeoutMiddleware(
+	logger framework.Logger,
+	router infrastructure.Router,
+	env *framework.Env,
+) TimeoutMiddleware {
+	return TimeoutMiddleware{
+		logger:  logger,
+		router:  router,
+		timeout: env.RequestTimeout,
+	}
+}
+
+// Setup applies the timeout to every request, a zero timeout disables it
+func (tm TimeoutMiddleware) Setup() {
+	if tm.timeout <= 0 {
+		return
+	}
+
+	tm.logger.Info("Setting up timeout middleware")
+	tm.router.Use(tm.Handle())
+}
+
+// Handle wraps the request context with the timeout
+// handlers and queries observing the request context stop once it is exceeded
+// and a 504 is returned when nothing has been written yet
+func (tm TimeoutMiddleware) Handle() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		timeoutCtx, cancel := context.WithTimeout(ctx.Request.Context(), tm.timeout)
+		defer cancel()
+
+		ctx.Request = ctx.Request.WithContext(timeoutCtx)
+		ctx.Next()
+
+		if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) && !ctx.Writer.Written() {
+			tm.logger.Warnf("request %s %s timed out after %s", ctx.Request.Method, ctx.Request.URL.Path, tm.timeout)
+			responses.HandleError(ctx, tm.logger, errorz.ErrRequestTimeout)
+			ctx.Abort()
+		}
+	}
+}
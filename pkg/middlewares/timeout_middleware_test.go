@@ -0,0 +1,57 @@
+package middlewares_test
+
+import (
+	"clean-architecture/pkg/errorz"
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/pkg/middlewares"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := infrastructure.Router{Engine: gin.New()}
+	logger := framework.CreateTestLogger(t)
+
+	timeout := middlewares.NewTimeoutMiddleware(logger, router, &framework.Env{
+		RequestTimeout: 20 * time.Millisecond,
+	})
+	timeout.Setup()
+
+	router.GET("/slow", func(c *gin.Context) {
+		select {
+		case <-time.After(time.Second):
+			c.JSON(http.StatusOK, gin.H{"data": "done"})
+		case <-c.Request.Context().Done():
+		}
+	})
+	router.GET("/fast", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "done"})
+	})
+
+	t.Run("Slow Handler Times Out", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/slow", nil))
+
+		assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+
+		var body map[string]string
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, errorz.ErrRequestTimeout.Error(), body["error"])
+	})
+
+	t.Run("Fast Handler Responds", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/fast", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
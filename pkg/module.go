@@ -4,6 +4,7 @@ import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/infrastructure"
 	"clean-architecture/pkg/middlewares"
+	"clean-architecture/pkg/openapi"
 	"clean-architecture/pkg/services"
 
 	"go.uber.org/fx"
@@ -19,4 +20,5 @@ var Module = fx.Module("pkg",
 	services.Module,
 	infrastructure.Module,
 	middlewares.Module,
+	openapi.Module,
 )
@@ -0,0 +1,11 @@
+package openapi
+
+import "go.uber.org/fx"
+
+// Module exports dependency
+var Module = fx.Module("openapi",
+	fx.Options(
+		fx.Provide(NewSpec),
+		fx.Invoke(RegisterRoute),
+	),
+)
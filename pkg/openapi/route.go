@@ -0,0 +1,18 @@
+package openapi
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterRoute serves the OpenAPI document built from the routes registered on the router
+func RegisterRoute(logger framework.Logger, router infrastructure.Router, spec *Spec) {
+	logger.Info("Setting up openapi route")
+
+	router.GET("/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec.Build(router.Routes()))
+	})
+}
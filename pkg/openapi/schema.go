@@ -0,0 +1,136 @@
+package openapi
+
+import (
+	"clean-architecture/pkg/types"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	uuidType       = reflect.TypeOf(uuid.UUID{})
+	binaryUUIDType = reflect.TypeOf(types.BinaryUUID{})
+	marshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// SchemaOf describes the JSON encoding of a value using its json and binding tags
+func SchemaOf(value any) *Schema {
+	if value == nil {
+		return &Schema{}
+	}
+	return schemaOf(reflect.TypeOf(value))
+}
+
+func schemaOf(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case uuidType, binaryUUIDType:
+		return &Schema{Type: "string", Format: "uuid"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := schemaOf(t.Elem())
+		schema.Nullable = true
+		return schema
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		// raw JSON values such as datatypes.JSON marshal themselves
+		if t.Implements(marshalerType) {
+			return &Schema{}
+		}
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: schemaOf(t.Elem())}
+	case reflect.Struct:
+		schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+		addProperties(schema, t)
+		return schema
+	}
+
+	// interfaces and anything else can hold any value
+	return &Schema{}
+}
+
+// addProperties adds the exported fields of a struct, flattening embedded structs like encoding/json
+func addProperties(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := tagName(field, "json")
+		if !ok {
+			continue
+		}
+		if name == "" {
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				addProperties(schema, field.Type)
+				continue
+			}
+			name = field.Name
+		}
+
+		schema.Properties[name] = schemaOf(field.Type)
+		if isRequired(field) {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// queryParameters lists the query parameters of a struct bound with form tags
+func queryParameters(value any) []Parameter {
+	t := reflect.TypeOf(value)
+	var params []Parameter
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := tagName(field, "form")
+		if !ok || name == "" || !field.IsExported() {
+			continue
+		}
+
+		params = append(params, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: isRequired(field),
+			Schema:   schemaOf(field.Type),
+		})
+	}
+	return params
+}
+
+// tagName returns the name from a struct tag, false when the field is skipped
+func tagName(field reflect.StructField, key string) (string, bool) {
+	tag := field.Tag.Get(key)
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	return name, true
+}
+
+func isRequired(field reflect.StructField) bool {
+	for _, rule := range strings.Split(field.Tag.Get("binding"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package openapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Version of the OpenAPI specification the document is written against
+const Version = "3.0.3"
+
+// Operation describes the request and response of a route
+// Request is bound from the JSON body, Query from the query string using form tags
+type Operation struct {
+	Summary  string
+	Tag      string
+	Request  any
+	Query    any
+	Response any
+	Status   int
+}
+
+// Spec collects operation descriptions registered by the domain routes
+type Spec struct {
+	mu         sync.RWMutex
+	title      string
+	operations map[string]Operation
+}
+
+// NewSpec creates a new spec
+func NewSpec() *Spec {
+	return &Spec{
+		title:      "Clean Architecture API",
+		operations: map[string]Operation{},
+	}
+}
+
+// Describe attaches an operation to a route, path uses the gin syntax e.g. /api/todos/:id
+func (s *Spec) Describe(method, path string, operation Operation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.operations[method+" "+path] = operation
+}
+
+// Build assembles the document from the registered routes
+// routes without a description are listed with their path parameters only
+func (s *Spec) Build(routes gin.RoutesInfo) Document {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path == routes[j].Path {
+			return routes[i].Method < routes[j].Method
+		}
+		return routes[i].Path < routes[j].Path
+	})
+
+	doc := Document{
+		OpenAPI: Version,
+		Info:    Info{Title: s.title, Version: "1.0.0"},
+		Paths:   map[string]PathItem{},
+	}
+
+	for _, route := range routes {
+		path, params := convertPath(route.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = PathItem{}
+		}
+
+		described := s.operations[route.Method+" "+route.Path]
+		item[strings.ToLower(route.Method)] = newOperationObject(described, params)
+		doc.Paths[path] = item
+	}
+
+	return doc
+}
+
+// newOperationObject converts a described operation into the document format
+func newOperationObject(operation Operation, params []string) OperationObject {
+	object := OperationObject{
+		Summary:   operation.Summary,
+		Responses: map[string]ResponseObject{},
+	}
+	if operation.Tag != "" {
+		object.Tags = []string{operation.Tag}
+	}
+
+	for _, param := range params {
+		object.Parameters = append(object.Parameters, Parameter{
+			Name:     param,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	if operation.Query != nil {
+		object.Parameters = append(object.Parameters, queryParameters(operation.Query)...)
+	}
+
+	if operation.Request != nil {
+		object.RequestBody = &RequestBody{
+			Required: true,
+			Content:  jsonContent(operation.Request),
+		}
+	}
+
+	status := operation.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	response := ResponseObject{Description: http.StatusText(status)}
+	if operation.Response != nil {
+		response.Content = jsonContent(operation.Response)
+	}
+	object.Responses[strconv.Itoa(status)] = response
+
+	return object
+}
+
+// convertPath turns gin path parameters into OpenAPI ones e.g. /todos/:id -> /todos/{id}
+func convertPath(path string) (string, []string) {
+	var params []string
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			name := segment[1:]
+			params = append(params, name)
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+func jsonContent(value any) map[string]MediaType {
+	return map[string]MediaType{
+		"application/json": {Schema: SchemaOf(value)},
+	}
+}
+
+// PaginationQuery describes the page and limit query parameters of list routes
+type PaginationQuery struct {
+	Page  int `form:"page"`
+	Limit int `form:"limit"`
+}
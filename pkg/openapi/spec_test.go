@@ -0,0 +1,91 @@
+package openapi_test
+
+import (
+	"clean-architecture/pkg/openapi"
+	"clean-architecture/pkg/responses"
+	"clean-architecture/pkg/types"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type itemRequest struct {
+	Name     string           `json:"name" binding:"required"`
+	OwnerID  types.BinaryUUID `json:"owner_id"`
+	Internal string           `json:"-"`
+}
+
+type itemResponse struct {
+	ID        string     `json:"id"`
+	Tags      []string   `json:"tags"`
+	DeletedAt *time.Time `json:"deleted_at"`
+}
+
+func TestSpecBuild(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handler := func(c *gin.Context) {}
+	router.POST("/api/items", handler)
+	router.GET("/api/items", handler)
+	router.GET("/api/items/:id", handler)
+
+	spec := openapi.NewSpec()
+	spec.Describe(http.MethodPost, "/api/items", openapi.Operation{
+		Summary:  "Create item",
+		Tag:      "item",
+		Request:  itemRequest{},
+		Response: responses.DetailResponseType[itemResponse]{},
+		Status:   http.StatusCreated,
+	})
+	spec.Describe(http.MethodGet, "/api/items", openapi.Operation{
+		Query:    openapi.PaginationQuery{},
+		Response: responses.ListResponseType[itemResponse]{},
+	})
+
+	raw, err := json.Marshal(spec.Build(router.Routes()))
+	require.NoError(t, err)
+
+	var doc openapi.Document
+	require.NoError(t, json.Unmarshal(raw, &doc))
+
+	assert.Equal(t, openapi.Version, doc.OpenAPI)
+	assert.Len(t, doc.Paths, 2)
+
+	t.Run("Request And Response Schemas", func(t *testing.T) {
+		create := doc.Paths["/api/items"]["post"]
+		assert.Equal(t, []string{"item"}, create.Tags)
+
+		request := create.RequestBody.Content["application/json"].Schema
+		assert.Equal(t, []string{"name"}, request.Required)
+		assert.Equal(t, "uuid", request.Properties["owner_id"].Format)
+		assert.NotContains(t, request.Properties, "Internal")
+
+		response := create.Responses["201"].Content["application/json"].Schema
+		item := response.Properties["item"]
+		assert.Equal(t, "array", item.Properties["tags"].Type)
+		assert.Equal(t, "date-time", item.Properties["deleted_at"].Format)
+		assert.True(t, item.Properties["deleted_at"].Nullable)
+	})
+
+	t.Run("Query Parameters", func(t *testing.T) {
+		list := doc.Paths["/api/items"]["get"]
+		require.Len(t, list.Parameters, 2)
+		assert.Equal(t, "page", list.Parameters[0].Name)
+		assert.Equal(t, "query", list.Parameters[0].In)
+		assert.Contains(t, list.Responses["200"].Content["application/json"].Schema.Properties, "pagination")
+	})
+
+	t.Run("Undescribed Route With Path Parameter", func(t *testing.T) {
+		get, ok := doc.Paths["/api/items/{id}"]["get"]
+		require.True(t, ok)
+		require.Len(t, get.Parameters, 1)
+		assert.Equal(t, "id", get.Parameters[0].Name)
+		assert.True(t, get.Parameters[0].Required)
+		assert.Contains(t, get.Responses, "200")
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"clean-architecture/pkg/errorz"
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/utils"
+	"context"
 	"errors"
 	"net/http"
 
@@ -53,6 +54,13 @@ func HandleError(
 		return
 	}
 
+	if errors.Is(err, context.DeadlineExceeded) {
+		ctx.JSON(http.StatusGatewayTimeout, gin.H{
+			"error": errorz.ErrRequestTimeout.Error(),
+		})
+		return
+	}
+
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		ctx.JSON(http.StatusNotFound, gin.H{
 			"error": gorm.ErrRecordNotFound.Error(),
@@ -5,7 +5,9 @@ import (
 	"clean-architecture/pkg/framework"
 	"clean-architecture/pkg/responses"
 	"clean-architecture/pkg/utils"
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -59,6 +61,13 @@ func TestHandleError(t *testing.T) {
 			expectedBody:        `{"error":"record not found"}`,
 			expectSentryCapture: false,
 		},
+		{
+			name:                "Handle Deadline Exceeded Error",
+			err:                 fmt.Errorf("query failed: %w", context.DeadlineExceeded),
+			expectedStatusCode:  http.StatusGatewayTimeout,
+			expectedBody:        `{"error":"Request timed out"}`,
+			expectSentryCapture: false,
+		},
 		{
 			name:                "Handle Generic Error",
 			err:                 errors.New("something went wrong"),
@@ -17,8 +17,27 @@ type ErrorResponseType struct {
 }
 
 type PaginationResponseType struct {
-	Total   int64 `json:"total"`
-	HasNext bool  `json:"has_next"`
+	Total       int64 `json:"total"`
+	HasNext     bool  `json:"has_next"`
+	CurrentPage int   `json:"current_page,omitempty"`
+	PerPage     int   `json:"per_page,omitempty"`
+	LastPage    int   `json:"last_page,omitempty"`
+}
+
+// NewPagination builds pagination details for the given page, page size and total count
+func NewPagination(page, limit int, total int64) PaginationResponseType {
+	lastPage := 1
+	if limit > 0 && total > 0 {
+		lastPage = int((total + int64(limit) - 1) / int64(limit))
+	}
+
+	return PaginationResponseType{
+		Total:       total,
+		HasNext:     page < lastPage,
+		CurrentPage: page,
+		PerPage:     limit,
+		LastPage:    lastPage,
+	}
 }
 
 type ListResponseType[T any] struct {
@@ -50,18 +69,18 @@ func ListResponse[T any](ctx *gin.Context, statusCode int, response ListResponse
 }
 
 // JSONWithPagination : json response function
+// fills the pagination details with NewPagination from the page and limit set on the context
 func JSONWithPagination[T any](ctx *gin.Context, statusCode int, response ListResponseType[T]) {
-	limit, _ := ctx.MustGet(framework.Limit).(int64)
-	size, _ := ctx.MustGet(framework.Page).(int64)
+	page := ctx.GetInt(framework.Page)
+	if page < 1 {
+		page = 1
+	}
+	limit := ctx.GetInt(framework.Limit)
+	if limit < 1 {
+		limit = 10
+	}
 
-	ctx.JSON(
-		statusCode,
-		ListResponseType[T]{
-			Items: response.Items,
-			Pagination: PaginationResponseType{
-				Total:   response.Pagination.Total,
-				HasNext: limit*size < response.Pagination.Total,
-			},
-		},
-	)
+	response.Pagination = NewPagination(page, limit, response.Pagination.Total)
+
+	ctx.JSON(statusCode, response)
 }
@@ -0,0 +1,101 @@
+package responses_test
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/responses"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPagination(t *testing.T) {
+	testCases := []struct {
+		name     string
+		page     int
+		limit    int
+		total    int64
+		expected responses.PaginationResponseType
+	}{
+		{
+			name:     "First Of Several Pages",
+			page:     1,
+			limit:    10,
+			total:    25,
+			expected: responses.PaginationResponseType{Total: 25, HasNext: true, CurrentPage: 1, PerPage: 10, LastPage: 3},
+		},
+		{
+			name:     "Exactly Full Last Page",
+			page:     2,
+			limit:    10,
+			total:    20,
+			expected: responses.PaginationResponseType{Total: 20, HasNext: false, CurrentPage: 2, PerPage: 10, LastPage: 2},
+		},
+		{
+			name:     "No Items",
+			page:     1,
+			limit:    10,
+			total:    0,
+			expected: responses.PaginationResponseType{Total: 0, HasNext: false, CurrentPage: 1, PerPage: 10, LastPage: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, responses.NewPagination(tc.page, tc.limit, tc.total))
+		})
+	}
+}
+
+func TestJSONWithPagination(t *testing.T) {
+	testCases := []struct {
+		name         string
+		page         int
+		limit        int
+		total        int64
+		expectedBody string
+	}{
+		{
+			name:         "Middle Page",
+			page:         2,
+			limit:        10,
+			total:        35,
+			expectedBody: `{"items":["a"],"message":"ok","pagination":{"total":35,"has_next":true,"current_page":2,"per_page":10,"last_page":4}}`,
+		},
+		{
+			name:         "Last Page",
+			page:         4,
+			limit:        10,
+			total:        35,
+			expectedBody: `{"items":["a"],"message":"ok","pagination":{"total":35,"has_next":false,"current_page":4,"per_page":10,"last_page":4}}`,
+		},
+		{
+			name:         "Empty Result",
+			page:         1,
+			limit:        10,
+			total:        0,
+			expectedBody: `{"items":["a"],"message":"ok","pagination":{"total":0,"has_next":false,"current_page":1,"per_page":10,"last_page":1}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(w)
+			ctx.Request, _ = http.NewRequest("GET", "/", nil)
+			ctx.Set(framework.Page, tc.page)
+			ctx.Set(framework.Limit, tc.limit)
+
+			responses.JSONWithPagination(ctx, http.StatusOK, responses.ListResponseType[string]{
+				Items:      []string{"a"},
+				Message:    "ok",
+				Pagination: responses.PaginationResponseType{Total: tc.total},
+			})
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.JSONEq(t, tc.expectedBody, w.Body.String())
+		})
+	}
+}
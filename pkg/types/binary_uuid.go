@@ -3,7 +3,7 @@ package types
 import (
 	"clean-architecture/pkg/errorz"
 	"database/sql/driver"
-	"errors"
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -17,31 +17,58 @@ func ParseUUID(id string) BinaryUUID {
 	return BinaryUUID(uuid.MustParse(id))
 }
 
+// ErrInvalidUUID -> sentinel wrapped by ShouldParseUUID errors
+var ErrInvalidUUID = errorz.ErrInvalidUUID
+
 // ShouldParseUUID -> parses string uuid to binary uuid with error
+// wrapping ErrInvalidUUID along with the offending value
 func ShouldParseUUID(id string) (BinaryUUID, error) {
 	UUID, err := uuid.Parse(id)
 	if err != nil {
-		return BinaryUUID{}, errorz.ErrInvalidUUID
+		return BinaryUUID{}, fmt.Errorf("%w %q", ErrInvalidUUID, id)
 	}
-	return BinaryUUID(UUID), err
+	return BinaryUUID(UUID), nil
 }
 
 func (b BinaryUUID) String() string {
 	return uuid.UUID(b).String()
 }
 
-// MarshalJSON -> convert to json string
+// MarshalJSON -> convert to json string, zero uuid is encoded as null
 func (b BinaryUUID) MarshalJSON() ([]byte, error) {
-	s := uuid.UUID(b)
-	str := "\"" + s.String() + "\""
-	return []byte(str), nil
+	if b.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(b.String())
 }
 
-// UnmarshalJSON -> convert from json string
+// UnmarshalJSON -> convert from json string, null and empty string give zero uuid
 func (b *BinaryUUID) UnmarshalJSON(by []byte) error {
-	s, err := uuid.ParseBytes(by)
+	if string(by) == "null" {
+		*b = BinaryUUID{}
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(by, &str); err != nil {
+		return err
+	}
+	if str == "" {
+		*b = BinaryUUID{}
+		return nil
+	}
+
+	s, err := uuid.Parse(str)
+	if err != nil {
+		return err
+	}
 	*b = BinaryUUID(s)
-	return err
+	return nil
+}
+
+// IsZero -> checks if the uuid is the zero (nil) uuid
+func (b BinaryUUID) IsZero() bool {
+	return uuid.UUID(b) == uuid.Nil
 }
 
 // GormDataType -> sql data type for gorm
@@ -49,16 +76,27 @@ func (BinaryUUID) GormDataType() string {
 	return "binary(16)"
 }
 
-// Scan -> scan value into BinaryUUID
+// Scan -> scan value into BinaryUUID, NULL is scanned as zero uuid
 func (b *BinaryUUID) Scan(value any) error {
+	if value == nil {
+		*b = BinaryUUID{}
+		return nil
+	}
+
 	bytes, ok := value.([]byte)
 	if !ok {
-		return errors.New(fmt.Sprint("Failed to unmarshal JSONB value:", value))
+		return fmt.Errorf("failed to scan BinaryUUID: unsupported type %T", value)
+	}
+	if len(bytes) != 16 {
+		return fmt.Errorf("failed to scan BinaryUUID: expected 16 bytes, got %d", len(bytes))
 	}
 
 	data, err := uuid.FromBytes(bytes)
+	if err != nil {
+		return err
+	}
 	*b = BinaryUUID(data)
-	return err
+	return nil
 }
 
 // Value -> return BinaryUUID to []bytes binary(16)
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"clean-architecture/pkg/errorz"
+	"clean-architecture/pkg/types"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type uuidPayload struct {
+	ID types.BinaryUUID `json:"id"`
+}
+
+func TestBinaryUUIDJSON(t *testing.T) {
+	id := types.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	t.Run("round trips through json", func(t *testing.T) {
+		data, err := json.Marshal(uuidPayload{ID: id})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`, string(data))
+
+		var decoded uuidPayload
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, id, decoded.ID)
+	})
+
+	t.Run("marshals zero value as null", func(t *testing.T) {
+		data, err := json.Marshal(uuidPayload{})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":null}`, string(data))
+	})
+
+	t.Run("unmarshals null and empty string as zero value", func(t *testing.T) {
+		for _, body := range []string{`{"id":null}`, `{"id":""}`} {
+			decoded := uuidPayload{ID: id}
+			assert.NoError(t, json.Unmarshal([]byte(body), &decoded), body)
+			assert.True(t, decoded.ID.IsZero(), body)
+		}
+	})
+
+	t.Run("rejects invalid uuid", func(t *testing.T) {
+		var decoded uuidPayload
+		assert.Error(t, json.Unmarshal([]byte(`{"id":"not-a-uuid"}`), &decoded))
+		assert.Error(t, json.Unmarshal([]byte(`{"id":42}`), &decoded))
+	})
+}
+
+func TestBinaryUUIDScan(t *testing.T) {
+	id := types.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	t.Run("scans NULL as zero value", func(t *testing.T) {
+		scanned := id
+		assert.NoError(t, scanned.Scan(nil))
+		assert.True(t, scanned.IsZero())
+	})
+
+	t.Run("scans a valid 16 byte value", func(t *testing.T) {
+		value, err := id.Value()
+		assert.NoError(t, err)
+
+		var scanned types.BinaryUUID
+		assert.NoError(t, scanned.Scan(value))
+		assert.Equal(t, id, scanned)
+	})
+
+	t.Run("rejects malformed values without modifying the uuid", func(t *testing.T) {
+		for _, value := range []any{[]byte{0x01, 0x02}, make([]byte, 17), "6ba7b810-9dad-11d1-80b4-00c04fd430c8", 42} {
+			scanned := id
+			assert.Error(t, scanned.Scan(value))
+			assert.Equal(t, id, scanned)
+		}
+	})
+}
+
+func TestShouldParseUUID(t *testing.T) {
+	t.Run("parses a valid uuid", func(t *testing.T) {
+		id, err := types.ShouldParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+		assert.NoError(t, err)
+		assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", id.String())
+	})
+
+	t.Run("wraps ErrInvalidUUID with the offending value", func(t *testing.T) {
+		_, err := types.ShouldParseUUID("not-a-uuid")
+		assert.True(t, errors.Is(err, types.ErrInvalidUUID))
+		assert.Contains(t, err.Error(), `"not-a-uuid"`)
+
+		var apiErr *errorz.APIError
+		assert.True(t, errors.As(err, &apiErr))
+		assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	})
+}
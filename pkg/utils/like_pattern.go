@@ -0,0 +1,14 @@
+package utils
+
+import "strings"
+
+// LikeEscape is the escape character of the patterns built by ContainsPattern, used as LIKE ? ESCAPE ?
+const LikeEscape = `\`
+
+var likeEscaper = strings.NewReplacer(LikeEscape, LikeEscape+LikeEscape, "%", LikeEscape+"%", "_", LikeEscape+"_")
+
+// ContainsPattern -> builds a LIKE pattern matching values containing the term literally
+// so that % and _ in the term are not treated as wildcards
+func ContainsPattern(term string) string {
+	return "%" + likeEscaper.Replace(term) + "%"
+}
@@ -0,0 +1,27 @@
+package utils_test
+
+import (
+	"clean-architecture/pkg/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsPattern(t *testing.T) {
+	testCases := []struct {
+		name     string
+		term     string
+		expected string
+	}{
+		{name: "Plain Term", term: "room", expected: "%room%"},
+		{name: "Percent Sign", term: "100%", expected: `%100\%%`},
+		{name: "Underscore", term: "_", expected: `%\_%`},
+		{name: "Backslash", term: `a\b`, expected: `%a\\b%`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, utils.ContainsPattern(tc.term))
+		})
+	}
+}
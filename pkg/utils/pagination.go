@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"clean-architecture/pkg/errorz"
 	"clean-architecture/pkg/framework"
+	"fmt"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -43,3 +45,46 @@ func BuildPagination(ctx *gin.Context) Pagination {
 		Offset: (page - 1) * limit,
 	}
 }
+
+// MaxPageLimit is the largest page size returned by ParsePagination, larger limits are capped to it
+const MaxPageLimit = 100
+
+// ParsePagination parses page and limit query params, defaulting to page 1 and limit 10 when absent or zero.
+// Non-numeric and negative values return ErrInvalidPagination, limits above MaxPageLimit are capped.
+func ParsePagination(ctx *gin.Context) (Pagination, error) {
+	page, err := parsePaginationParam(ctx, "page", 1)
+	if err != nil {
+		return Pagination{}, err
+	}
+
+	limit, err := parsePaginationParam(ctx, "limit", 10)
+	if err != nil {
+		return Pagination{}, err
+	}
+	limit = min(limit, MaxPageLimit)
+
+	ctx.Set(framework.Page, page)
+	ctx.Set(framework.Limit, limit)
+
+	return Pagination{
+		Page:   page,
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	}, nil
+}
+
+func parsePaginationParam(ctx *gin.Context, key string, defaultValue int) (int, error) {
+	str, ok := ctx.GetQuery(key)
+	if !ok || str == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.Atoi(str)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("%w: %s must be a positive number, got %q", errorz.ErrInvalidPagination, key, str)
+	}
+	if value == 0 {
+		return defaultValue, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,72 @@
+package utils_test
+
+import (
+	"clean-architecture/pkg/errorz"
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/utils"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePagination(t *testing.T) {
+	testCases := []struct {
+		name          string
+		query         string
+		expected      utils.Pagination
+		expectedError bool
+	}{
+		{
+			name:     "Defaults When Absent",
+			query:    "",
+			expected: utils.Pagination{Page: 1, Limit: 10, Offset: 0},
+		},
+		{
+			name:     "Provided Values",
+			query:    "?page=3&limit=20",
+			expected: utils.Pagination{Page: 3, Limit: 20, Offset: 40},
+		},
+		{
+			name:          "Negative Page",
+			query:         "?page=-1",
+			expectedError: true,
+		},
+		{
+			name:          "Non Numeric Limit",
+			query:         "?limit=ten",
+			expectedError: true,
+		},
+		{
+			name:     "Zero Values Use Defaults",
+			query:    "?page=0&limit=0",
+			expected: utils.Pagination{Page: 1, Limit: 10, Offset: 0},
+		},
+		{
+			name:     "Limit Above Maximum Is Capped",
+			query:    "?page=2&limit=500",
+			expected: utils.Pagination{Page: 2, Limit: utils.MaxPageLimit, Offset: utils.MaxPageLimit},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+			ctx.Request, _ = http.NewRequest("GET", "/"+tc.query, nil)
+
+			pagination, err := utils.ParsePagination(ctx)
+			if tc.expectedError {
+				assert.True(t, errors.Is(err, errorz.ErrInvalidPagination))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, pagination)
+			assert.Equal(t, tc.expected.Page, ctx.GetInt(framework.Page))
+			assert.Equal(t, tc.expected.Limit, ctx.GetInt(framework.Limit))
+		})
+	}
+}
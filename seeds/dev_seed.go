@@ -0,0 +1,81 @@
+package seeds
+
+import (
+	"clean-architecture/domain/models"
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"time"
+)
+
+type DevSeed struct {
+	logger framework.Logger
+	db     infrastructure.Database
+	env    *framework.Env
+}
+
+// NewDevSeed creates sample data seed for local development
+func NewDevSeed(
+	logger framework.Logger,
+	db infrastructure.Database,
+	env *framework.Env,
+) DevSeed {
+	return DevSeed{
+		logger: logger,
+		db:     db,
+		env:    env,
+	}
+}
+
+// Enabled reports whether sample data is allowed to be seeded in the current environment
+func (s DevSeed) Enabled() bool {
+	return s.env.SeedEnabled && s.env.Environment != "production"
+}
+
+// Setup seeds sample organizations, users and resources,
+// records already present are left untouched so running it again is a no-op
+func (s DevSeed) Setup() {
+	if !s.Enabled() {
+		s.logger.Info("sample data seed is disabled, set SEED_ENABLED=true outside production to enable it")
+		return
+	}
+
+	s.logger.Info("🌱 seeding sample data...")
+
+	organizations := []models.Organization{
+		{Name: "Acme Corporation", Location: "Kathmandu", EstablishedAt: time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "Globex", Location: "Pokhara", EstablishedAt: time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for i := range organizations {
+		if err := s.db.Where(models.Organization{Name: organizations[i].Name}).
+			FirstOrCreate(&organizations[i]).Error; err != nil {
+			s.logger.Error("failed to seed organization ", organizations[i].Name, ": ", err.Error())
+			return
+		}
+	}
+
+	users := []models.User{
+		{Email: "jane.doe@example.com", FirstName: "Jane", LastName: "Doe", IsActive: true, IsEmailVerified: true},
+		{Email: "john.doe@example.com", FirstName: "John", LastName: "Doe", IsActive: true, IsEmailVerified: true},
+	}
+	for i := range users {
+		if err := s.db.Where(models.User{Email: users[i].Email}).
+			FirstOrCreate(&users[i]).Error; err != nil {
+			s.logger.Error("failed to seed user ", users[i].Email, ": ", err.Error())
+			return
+		}
+	}
+
+	resources := []models.Resource{
+		{Name: "Conference Room A", Description: "Main floor conference room", Type: "room", Capacity: 12, Location: "Floor 1"},
+		{Name: "Projector", Description: "Portable HD projector", Type: "equipment", Capacity: 1, Location: "Storage"},
+	}
+	for i := range resources {
+		if err := s.db.Where(models.Resource{Name: resources[i].Name}).
+			FirstOrCreate(&resources[i]).Error; err != nil {
+			s.logger.Error("failed to seed resource ", resources[i].Name, ": ", err.Error())
+			return
+		}
+	}
+
+	s.logger.Info("Sample data seeded")
+}
@@ -0,0 +1,75 @@
+package seeds_test
+
+import (
+	"clean-architecture/domain/models"
+	"clean-architecture/pkg/framework"
+	"clean-architecture/pkg/infrastructure"
+	"clean-architecture/seeds"
+	"clean-architecture/testutil"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx"
+)
+
+var _ = Describe("Seeds/DevSeed", Ordered, func() {
+	var (
+		db      infrastructure.Database
+		env     *framework.Env
+		devSeed seeds.DevSeed
+	)
+
+	BeforeAll(func() {
+		err := testutil.DI(t,
+			fx.Provide(seeds.NewDevSeed),
+			fx.Populate(&db),
+			fx.Populate(&env),
+			fx.Populate(&devSeed),
+		)
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	count := func(model any) int64 {
+		var total int64
+		Expect(db.Model(model).Count(&total).Error).To(BeNil())
+		return total
+	}
+
+	It("should not seed when disabled", func() {
+		env.SeedEnabled = false
+		devSeed.Setup()
+
+		Expect(count(&models.Organization{})).To(BeZero())
+	})
+
+	It("should never seed in production", func() {
+		env.SeedEnabled = true
+		env.Environment = "production"
+		defer func() {
+			env.Environment = "local"
+		}()
+
+		Expect(devSeed.Enabled()).To(BeFalse())
+	})
+
+	It("should not create duplicates when run twice", func() {
+		env.SeedEnabled = true
+		env.Environment = "local"
+
+		devSeed.Setup()
+		organizations := count(&models.Organization{})
+		users := count(&models.User{})
+		resources := count(&models.Resource{})
+
+		Expect(organizations).To(BeNumerically(">", 0))
+		Expect(users).To(BeNumerically(">", 0))
+		Expect(resources).To(BeNumerically(">", 0))
+
+		devSeed.Setup()
+		Expect(count(&models.Organization{})).To(Equal(organizations))
+		Expect(count(&models.User{})).To(Equal(users))
+		Expect(count(&models.Resource{})).To(Equal(resources))
+	})
+})
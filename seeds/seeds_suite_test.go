@@ -0,0 +1,20 @@
+package seeds_test
+
+import (
+	"clean-architecture/pkg/utils"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSeeds(t *testing.T) {
+	utils.ChDir("..")
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Seeds Suite")
+}
+
+var t GinkgoTInterface
+var _ = BeforeSuite(func() {
+	t = GinkgoT()
+})
@@ -10,20 +10,40 @@ import (
 	"time"
 
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
 	tc "github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
-type MySQLContainer struct {
+const (
+	EngineMySQL    = "mysql"
+	EnginePostgres = "postgres"
+)
+
+// DBContainer database test container details
+type DBContainer struct {
+	Engine    string
 	Container tc.Container
 	Host      string
 	Port      string
 	Cleanup   func()
 }
 
-func SetupMySQLContainer(ctx context.Context, env *framework.Env) (*MySQLContainer, error) {
+// SetupDBContainer starts the container for engine selected by TEST_DB_ENGINE, defaults to mysql
+func SetupDBContainer(ctx context.Context, env *framework.Env) (*DBContainer, error) {
+	switch env.TestDBEngine {
+	case "", EngineMySQL:
+		return SetupMySQLContainer(ctx, env)
+	case EnginePostgres:
+		return SetupPostgresContainer(ctx, env)
+	default:
+		return nil, fmt.Errorf("unsupported test database engine: %s", env.TestDBEngine)
+	}
+}
+
+func SetupMySQLContainer(ctx context.Context, env *framework.Env) (*DBContainer, error) {
 	log.Printf("Setting up MySQL test container with image: %s", env.DBName)
 
 	req := tc.ContainerRequest{
@@ -73,7 +93,8 @@ func SetupMySQLContainer(ctx context.Context, env *framework.Env) (*MySQLContain
 		return nil, fmt.Errorf("failed to get container port: %w", err)
 	}
 
-	return &MySQLContainer{
+	return &DBContainer{
+		Engine:    EngineMySQL,
 		Container: mysqlContainer,
 		Host:      host,
 		Port:      port.Port(),
@@ -84,23 +105,18 @@ func SetupMySQLContainer(ctx context.Context, env *framework.Env) (*MySQLContain
 // ConnectToDatabase establishes a connection to the database
 func ConnectToDatabase(
 	ctx context.Context,
-	container *MySQLContainer,
+	container *DBContainer,
 	env *framework.Env,
 ) (*gorm.DB, error) {
 	log.Printf("Container host: %s, port: %s", container.Host, container.Port)
-	dsn := fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		env.DBUsername,
-		env.DBPassword,
-		net.JoinHostPort(container.Host, container.Port),
-		env.DBName,
-	)
-	log.Printf("Attempting to connect to DSN: tcp(%s)/%s", net.JoinHostPort(container.Host, container.Port), env.DBName)
+	dialector := dialectorFor(container, env)
+	log.Printf("Attempting to connect to %s: tcp(%s)/%s", container.Engine, net.JoinHostPort(container.Host, container.Port), env.DBName)
 
 	const maxRetries = 10
 	const retryDelay = 5 * time.Second
 
 	for i := range maxRetries {
-		db, openErr := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+		db, openErr := gorm.Open(dialector, &gorm.Config{})
 		if openErr == nil {
 			sqlDB, sqlErr := db.DB()
 			if sqlErr == nil {
@@ -126,16 +142,38 @@ func ConnectToDatabase(
 	return nil, fmt.Errorf("unexpected error in connection loop")
 }
 
-// NewTestDatabase creates a test database using a MySQL container
+// dialectorFor returns the gorm dialector for the container engine
+func dialectorFor(container *DBContainer, env *framework.Env) gorm.Dialector {
+	if container.Engine == EnginePostgres {
+		dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+			container.Host,
+			container.Port,
+			env.DBUsername,
+			env.DBPassword,
+			env.DBName,
+		)
+		return postgres.Open(dsn)
+	}
+
+	dsn := fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		env.DBUsername,
+		env.DBPassword,
+		net.JoinHostPort(container.Host, container.Port),
+		env.DBName,
+	)
+	return mysql.Open(dsn)
+}
+
+// NewTestDatabase creates a test database using a container for the selected engine
 func NewTestDatabase(
 	logger framework.Logger,
 	env *framework.Env,
 ) infrastructure.Database {
 	logger.Info("Creating test database...")
 	ctx := context.Background()
-	container, err := SetupMySQLContainer(ctx, env)
+	container, err := SetupDBContainer(ctx, env)
 	if err != nil {
-		log.Printf("Failed to setup MySQL container: %v", err)
+		log.Printf("Failed to setup database container: %v", err)
 		return infrastructure.Database{}
 	}
 
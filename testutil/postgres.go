@@ -0,0 +1,67 @@
+package testutil
+
+import (
+	"clean-architecture/pkg/framework"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	tc "github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+func SetupPostgresContainer(ctx context.Context, env *framework.Env) (*DBContainer, error) {
+	log.Printf("Setting up Postgres test container for database: %s", env.DBName)
+
+	req := tc.ContainerRequest{
+		Image: "postgres:16-alpine",
+		Env: map[string]string{
+			"POSTGRES_DB":       env.DBName,
+			"POSTGRES_USER":     env.DBUsername,
+			"POSTGRES_PASSWORD": env.DBPassword,
+		},
+		ExposedPorts: []string{"5432/tcp"},
+		// postgres restarts once after running init scripts, so wait for the second ready log
+		WaitingFor: wait.ForLog("database system is ready to accept connections").
+			WithOccurrence(2).
+			WithStartupTimeout(120 * time.Second),
+	}
+
+	postgresContainer, err := tc.GenericContainer(ctx, tc.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start container: %w", err)
+	}
+
+	cleanup := func() {
+		log.Println("Cleaning up Postgres container...")
+		if err := postgresContainer.Terminate(ctx); err != nil {
+			log.Printf("Error terminating container: %v", err)
+		} else {
+			log.Println("Container terminated.")
+		}
+	}
+
+	host, err := postgresContainer.Host(ctx)
+	if err != nil {
+		cleanup()
+		return nil, fmt.Errorf("failed to get container host: %w", err)
+	}
+
+	port, err := postgresContainer.MappedPort(ctx, "5432")
+	if err != nil {
+		cleanup()
+		return nil, fmt.Errorf("failed to get container port: %w", err)
+	}
+
+	return &DBContainer{
+		Engine:    EnginePostgres,
+		Container: postgresContainer,
+		Host:      host,
+		Port:      port.Port(),
+		Cleanup:   cleanup,
+	}, nil
+}
@@ -0,0 +1,37 @@
+package testutil_test
+
+import (
+	"clean-architecture/pkg/framework"
+	"clean-architecture/testutil"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	tc "github.com/testcontainers/testcontainers-go"
+)
+
+func TestSetupPostgresContainer(t *testing.T) {
+	tc.SkipIfProviderIsNotHealthy(t)
+
+	ctx := context.Background()
+	env := &framework.Env{
+		DBUsername: "test",
+		DBPassword: "test",
+		DBName:     "test",
+	}
+
+	container, err := testutil.SetupPostgresContainer(ctx, env)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer container.Cleanup()
+
+	db, err := testutil.ConnectToDatabase(ctx, container, env)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var result int
+	assert.NoError(t, db.Raw("SELECT 1").Scan(&result).Error)
+	assert.Equal(t, 1, result)
+}
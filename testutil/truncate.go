@@ -0,0 +1,44 @@
+package testutil
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// TruncateTables empties the tables of the given models so specs start from a known state.
+// Call it from BeforeEach in suites that assert on counts.
+func TruncateTables(db *gorm.DB, models ...any) error {
+	tables := make([]string, 0, len(models))
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			return fmt.Errorf("failed to resolve table for %T: %w", model, err)
+		}
+		tables = append(tables, stmt.Schema.Table)
+	}
+
+	// foreign key checks are session scoped, so keep everything on one connection
+	return db.Connection(func(tx *gorm.DB) error {
+		if tx.Dialector.Name() == EnginePostgres {
+			for _, table := range tables {
+				if err := tx.Exec(fmt.Sprintf("TRUNCATE TABLE %q RESTART IDENTITY CASCADE", table)).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if err := tx.Exec("SET FOREIGN_KEY_CHECKS = 0").Error; err != nil {
+			return err
+		}
+		defer tx.Exec("SET FOREIGN_KEY_CHECKS = 1")
+
+		for _, table := range tables {
+			if err := tx.Exec(fmt.Sprintf("TRUNCATE TABLE `%s`", table)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}